@@ -8,7 +8,6 @@ require (
 	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
 	github.com/hashicorp/go-uuid v1.0.2
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.10.0
-	github.com/pkg/errors v0.9.1
 	github.com/stretchr/testify v1.7.0
 )
 
@@ -0,0 +1,225 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+func resourceAccessGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceAccessGroupCreate,
+		ReadContext:   resourceAccessGroupRead,
+		UpdateContext: resourceAccessGroupUpdate,
+		DeleteContext: resourceAccessGroupDelete,
+		CustomizeDiff: customizeDiffAccessGroup,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"source":      accessItemSchema(),
+			"destination": accessItemSchema(),
+		},
+	}
+}
+
+func accessItemSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Required: true,
+					ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+						value := api.AccessItemType(i.(string))
+						return diag.FromErr(value.Validate())
+					},
+				},
+				"all_covered": {
+					Type:     schema.TypeBool,
+					Optional: true,
+					Default:  false,
+				},
+				"parent": {
+					Type:     schema.TypeString,
+					Optional: true,
+				},
+				"children": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceAccessGroupCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	accessGroup, err := client.CreateAccessGroup(ctx, makeAccessGroupRequest(data))
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setAccessGroupData(data, accessGroup)
+}
+
+func resourceAccessGroupRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	accessGroup, err := client.GetAccessGroup(ctx, data.Id())
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setAccessGroupData(data, accessGroup)
+}
+
+func resourceAccessGroupUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	accessGroup, err := client.UpdateAccessGroup(ctx, data.Id(), makeAccessGroupRequest(data))
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setAccessGroupData(data, accessGroup)
+}
+
+func resourceAccessGroupDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	err := client.DeleteAccessGroup(ctx, data.Id())
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return nil
+}
+
+func makeAccessGroupRequest(data *schema.ResourceData) *api.AccessGroupRequest {
+	return &api.AccessGroupRequest{
+		Name:        data.Get("name").(string),
+		Description: data.Get("description").(string),
+		Source:      expandAccessItems(data.Get("source").([]interface{})),
+		Destination: expandAccessItems(data.Get("destination").([]interface{})),
+	}
+}
+
+func expandAccessItems(itemsList []interface{}) []api.AccessItem {
+	items := make([]api.AccessItem, len(itemsList))
+	for i, itemI := range itemsList {
+		itemData := itemI.(map[string]interface{})
+		items[i] = api.AccessItem{
+			Type:       api.AccessItemType(itemData["type"].(string)),
+			AllCovered: itemData["all_covered"].(bool),
+			Parent:     itemData["parent"].(string),
+			Children:   []string{},
+		}
+		if children, ok := itemData["children"].(*schema.Set); ok {
+			for _, child := range children.List() {
+				items[i].Children = append(items[i].Children, child.(string))
+			}
+		}
+	}
+	return items
+}
+
+// customizeDiffAccessGroup checks the source and destination items at plan time. An item either covers every
+// item of its type or lists its children, only services belong to a parent host or network. Values only known
+// after apply are not checked.
+func customizeDiffAccessGroup(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	for _, attribute := range []string{"source", "destination"} {
+		items, _ := diff.Get(attribute).([]interface{})
+		for i := range items {
+			err := validateAccessItem(diff, fmt.Sprintf("%s.%d", attribute, i))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateAccessItem(diff *schema.ResourceDiff, attribute string) error {
+	if diff.NewValueKnown(attribute+".all_covered") && diff.NewValueKnown(attribute+".children.#") {
+		allCovered := diff.Get(attribute + ".all_covered").(bool)
+		children := diff.Get(attribute + ".children").(*schema.Set).Len()
+		if allCovered && children > 0 {
+			return fmt.Errorf("%s: children can not be set when all_covered is enabled", attribute)
+		}
+		if !allCovered && children == 0 {
+			return fmt.Errorf("%s: either enable all_covered or set children", attribute)
+		}
+	}
+
+	if diff.NewValueKnown(attribute+".type") && diff.NewValueKnown(attribute+".parent") {
+		itemType := api.AccessItemType(diff.Get(attribute + ".type").(string))
+		if diff.Get(attribute+".parent").(string) != "" && itemType != api.AccessItemTypeService {
+			return fmt.Errorf("%s: parent can only be set for type %s", attribute, api.AccessItemTypeService)
+		}
+	}
+	return nil
+}
+
+func flattenAccessItems(items []api.AccessItem) []interface{} {
+	itemsData := make([]interface{}, len(items))
+	for i, item := range items {
+		itemsData[i] = map[string]interface{}{
+			"type":        string(item.Type),
+			"all_covered": item.AllCovered,
+			"parent":      item.Parent,
+			"children":    item.Children,
+		}
+	}
+	return itemsData
+}
+
+func setAccessGroupData(data *schema.ResourceData, accessGroup *api.AccessGroup) diag.Diagnostics {
+	data.SetId(accessGroup.ID)
+	err := data.Set("name", accessGroup.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("description", accessGroup.Description)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("source", flattenAccessItems(accessGroup.Source))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("destination", flattenAccessItems(accessGroup.Destination))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+func TestResourceAccessGroup_basic(t *testing.T) {
+	resourceName := "openvpn_access_group.test"
+	accessGroupName := "ag-" + RandomString(7)
+
+	client := getAuthenticatedClient(t)
+
+	host := createTestHost(t, client, getDefaultRegionID(t, client))
+	t.Cleanup(func() {
+		deleteTestHost(t, client, host.ID)
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		CheckDestroy:      testAccCheckAccessGroupDestroy(client),
+		Steps: []resource.TestStep{
+			{
+				Config: resourceAccessGroupOutputConfig("test", accessGroupName, host.ID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", accessGroupName),
+					resource.TestCheckResourceAttr(resourceName, "source.0.type", string(api.AccessItemTypeUserGroup)),
+					resource.TestCheckResourceAttr(resourceName, "source.0.all_covered", "true"),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.type", string(api.AccessItemTypeHost)),
+					resource.TestCheckResourceAttr(resourceName, "destination.0.children.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestMakeAccessGroupRequest(t *testing.T) {
+	// given
+	data := schema.TestResourceDataRaw(t, resourceAccessGroup().Schema, map[string]interface{}{
+		"source": []interface{}{
+			map[string]interface{}{"type": "USER_GROUP", "all_covered": true},
+		},
+		"destination": []interface{}{
+			map[string]interface{}{"type": "SERVICE", "parent": "host-id", "children": []interface{}{"service-id"}},
+		},
+	})
+
+	// when
+	request := makeAccessGroupRequest(data)
+
+	// then
+	assert.Equal(t, []api.AccessItem{{Type: api.AccessItemTypeUserGroup, AllCovered: true, Children: []string{}}}, request.Source)
+	assert.Equal(t, []api.AccessItem{{Type: api.AccessItemTypeService, Parent: "host-id", Children: []string{"service-id"}}}, request.Destination)
+}
+
+func TestCustomizeDiffAccessGroup(t *testing.T) {
+	for name, test := range map[string]struct {
+		item map[string]interface{}
+		err  string
+	}{
+		"all covered": {
+			item: map[string]interface{}{"type": "HOST", "all_covered": true},
+		},
+		"service of a host": {
+			item: map[string]interface{}{"type": "SERVICE", "parent": "host-id", "children": []interface{}{"service-id"}},
+		},
+		"children and all_covered": {
+			item: map[string]interface{}{"type": "HOST", "all_covered": true, "children": []interface{}{"host-id"}},
+			err:  "destination.0: children can not be set when all_covered is enabled",
+		},
+		"nothing covered": {
+			item: map[string]interface{}{"type": "HOST"},
+			err:  "destination.0: either enable all_covered or set children",
+		},
+		"parent of host": {
+			item: map[string]interface{}{"type": "HOST", "parent": "network-id", "children": []interface{}{"host-id"}},
+			err:  "destination.0: parent can only be set for type SERVICE",
+		},
+		"unknown children": {
+			item: map[string]interface{}{"type": "HOST", "children": []interface{}{unknownValue}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name": "access group",
+				"source": []interface{}{
+					map[string]interface{}{"type": "USER_GROUP", "all_covered": true},
+				},
+				"destination": []interface{}{test.item},
+			})
+
+			// when
+			_, err := resourceAccessGroup().Diff(context.Background(), nil, config, nil)
+
+			// then
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func testAccCheckAccessGroupDestroy(client *api.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openvpn_access_group" {
+				continue
+			}
+
+			_, err := client.GetAccessGroup(context.Background(), rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("access group %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func resourceAccessGroupOutputConfig(name, accessGroupName, hostID string) string {
+	return fmt.Sprintf(`
+provider "openvpn" {}
+
+resource "openvpn_access_group" "%s" {
+	name = "%s"
+
+	source {
+		type = "USER_GROUP"
+		all_covered = true
+	}
+
+	destination {
+		type = "HOST"
+		children = ["%s"]
+	}
+}
+`, name, accessGroupName, hostID)
+}
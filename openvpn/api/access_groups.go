@@ -0,0 +1,96 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type AccessItemType string
+
+type AccessGroup struct {
+	ID          string       `json:"id,omitempty"`
+	Name        string       `json:"name,omitempty"`
+	Description string       `json:"description,omitempty"`
+	Source      []AccessItem `json:"source,omitempty"`
+	Destination []AccessItem `json:"destination,omitempty"`
+}
+
+// AccessItem references user groups, hosts, networks or services. Either all items of the type are
+// covered or only the listed children, services are listed as children of their host or network.
+type AccessItem struct {
+	Type       AccessItemType `json:"type"`
+	AllCovered bool           `json:"allCovered"`
+	Parent     string         `json:"parent,omitempty"`
+	Children   []string       `json:"children,omitempty"`
+}
+
+type AccessGroupRequest struct {
+	Name        string       `json:"name"`
+	Description string       `json:"description"`
+	Source      []AccessItem `json:"source"`
+	Destination []AccessItem `json:"destination"`
+}
+
+const (
+	AccessGroupsEndpoint    = "/access-groups"
+	AccessGroupByIdEndpoint = "/access-groups/%s"
+)
+
+const (
+	AccessItemTypeUserGroup AccessItemType = "USER_GROUP"
+	AccessItemTypeNetwork   AccessItemType = "NETWORK"
+	AccessItemTypeHost      AccessItemType = "HOST"
+	AccessItemTypeService   AccessItemType = "SERVICE"
+)
+
+var AccessItemTypePossibleValues = []string{
+	string(AccessItemTypeUserGroup), string(AccessItemTypeNetwork), string(AccessItemTypeHost), string(AccessItemTypeService),
+}
+
+func (c *Client) GetAccessGroup(ctx context.Context, id string) (*AccessGroup, error) {
+	accessGroup := new(AccessGroup)
+
+	err := c.newRequest(ctx, "GET", c.apiEndpoint(AccessGroupByIdEndpoint, id), nil, accessGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return accessGroup, nil
+}
+
+func (c *Client) CreateAccessGroup(ctx context.Context, request *AccessGroupRequest) (*AccessGroup, error) {
+	accessGroup := new(AccessGroup)
+
+	err := c.newRequestJSON(ctx, "POST", c.apiEndpoint(AccessGroupsEndpoint), request, accessGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return accessGroup, nil
+}
+
+func (c *Client) UpdateAccessGroup(ctx context.Context, id string, request *AccessGroupRequest) (*AccessGroup, error) {
+	accessGroup := new(AccessGroup)
+
+	err := c.newRequestJSON(ctx, "PUT", c.apiEndpoint(AccessGroupByIdEndpoint, id), request, accessGroup)
+	if err != nil {
+		return nil, err
+	}
+
+	return accessGroup, nil
+}
+
+func (c *Client) DeleteAccessGroup(ctx context.Context, id string) error {
+	return c.newRequest(ctx, "DELETE", c.apiEndpoint(AccessGroupByIdEndpoint, id), nil, nil)
+}
+
+func (t AccessItemType) Validate() error {
+	for _, possibleValue := range AccessItemTypePossibleValues {
+		if string(t) == possibleValue {
+			return nil
+		}
+	}
+	possibleValues := strings.Join(AccessItemTypePossibleValues, ", ")
+	return fmt.Errorf("invalid value for AccessItemType: '%s'. Possible values are: %s", t, possibleValues)
+}
@@ -0,0 +1,125 @@
+package api
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetAccessGroup(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	authConfig := getAuthConfigTestData()
+	ctx := context.Background()
+	authData := &AuthData{AccessToken: "AccessToken"}
+
+	t.Run("non-authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
+
+		// when
+		_, err := client.GetAccessGroup(ctx, "123")
+
+		// then
+		assert.Error(t, err)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+
+		expectedAccessGroup := &AccessGroup{
+			ID:   "access-group-id",
+			Name: "developers to staging",
+			Source: []AccessItem{
+				{Type: AccessItemTypeUserGroup, Children: []string{"group-id"}},
+			},
+			Destination: []AccessItem{
+				{Type: AccessItemTypeService, Parent: "host-id", Children: []string{"service-id"}},
+				{Type: AccessItemTypeNetwork, AllCovered: true},
+			},
+		}
+
+		mockHttpClient.mockDo(t, expectedAccessGroup, func(request *http.Request) {
+			assertRequestAuthorizedWithToken(t, request, authData.AccessToken)
+			assert.Equal(t, "GET", request.Method)
+			assert.True(t, strings.HasSuffix(request.URL.Path, AccessGroupsEndpoint+"/"+expectedAccessGroup.ID))
+		})
+
+		// when
+		accessGroup, err := client.GetAccessGroup(ctx, expectedAccessGroup.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, expectedAccessGroup, accessGroup)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestClient_CreateAccessGroup(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	request := &AccessGroupRequest{
+		Name:        "everyone to hosts",
+		Source:      []AccessItem{{Type: AccessItemTypeUserGroup, AllCovered: true}},
+		Destination: []AccessItem{{Type: AccessItemTypeHost, Children: []string{"host-id"}}},
+	}
+	response := &AccessGroup{ID: "access-group-id", Name: request.Name}
+
+	mockHttpClient.mockDo(t, response, func(httpRequest *http.Request) {
+		assert.Equal(t, "POST", httpRequest.Method)
+		assert.True(t, strings.HasSuffix(httpRequest.URL.Path, AccessGroupsEndpoint))
+
+		body := &AccessGroupRequest{}
+		decodeRequestBody(t, httpRequest, body)
+		assert.Equal(t, request, body)
+	})
+
+	accessGroup, err := client.CreateAccessGroup(context.Background(), request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, response, accessGroup)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestClient_UpdateAccessGroup(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	request := &AccessGroupRequest{Name: "renamed"}
+	response := &AccessGroup{ID: "access-group-id", Name: request.Name}
+
+	mockHttpClient.mockDo(t, response, func(httpRequest *http.Request) {
+		assert.Equal(t, "PUT", httpRequest.Method)
+		assert.True(t, strings.HasSuffix(httpRequest.URL.Path, response.ID))
+	})
+
+	accessGroup, err := client.UpdateAccessGroup(context.Background(), response.ID, request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, response, accessGroup)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestClient_DeleteAccessGroup(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	mockHttpClient.mockDo(t, nil, func(request *http.Request) {
+		assert.Equal(t, "DELETE", request.Method)
+		assert.True(t, strings.HasSuffix(request.URL.Path, "access-group-id"))
+	})
+
+	err := client.DeleteAccessGroup(context.Background(), "access-group-id")
+
+	assert.NoError(t, err)
+	mockHttpClient.AssertExpectations(t)
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+)
+
+type Application struct {
+	ID              string             `json:"id"`
+	Name            string             `json:"name"`
+	Description     string             `json:"description"`
+	NetworkItemId   string             `json:"networkItemId"`
+	NetworkItemType NetworkItemType    `json:"networkItemType"`
+	Routes          []ApplicationRoute `json:"routes"`
+	Config          *ServiceConfig     `json:"config"`
+}
+
+// ApplicationRoute publishes a domain, AllowEmbeddedIp also routes IP addresses the domain resolves to
+// when they are used directly.
+type ApplicationRoute struct {
+	Description     string `json:"description,omitempty"`
+	Value           string `json:"value"`
+	AllowEmbeddedIp bool   `json:"allowEmbeddedIp"`
+}
+
+type ApplicationData struct {
+	Name            string
+	Description     string
+	NetworkItemId   string
+	NetworkItemType NetworkItemType
+	Routes          []ApplicationRoute
+	Config          *ServiceConfig
+}
+
+type ApplicationRequest struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Routes      []ApplicationRoute `json:"routes"`
+	Config      *ServiceConfig     `json:"config,omitempty"`
+}
+
+const (
+	ApplicationsEndpoint    = "/applications"
+	ApplicationByIdEndpoint = "/applications/%s"
+)
+
+func (c *Client) GetApplication(ctx context.Context, id string) (*Application, error) {
+	application := new(Application)
+	err := c.newRequest(ctx, "GET", c.apiEndpoint(ApplicationByIdEndpoint, id), nil, application)
+	if err != nil {
+		return nil, err
+	}
+
+	return application, nil
+}
+
+func (c *Client) CreateApplication(ctx context.Context, request *ApplicationData) (*Application, error) {
+	endpoint := c.networkItemUrl(c.apiEndpoint(ApplicationsEndpoint), request.NetworkItemId, request.NetworkItemType)
+
+	application := new(Application)
+	err := c.newRequestJSON(ctx, "POST", endpoint.String(), request.internalRequest(), application)
+	if err != nil {
+		return nil, err
+	}
+
+	return application, nil
+}
+
+func (c *Client) UpdateApplication(ctx context.Context, id string, request *ApplicationData) (*Application, error) {
+	endpoint := c.networkItemUrl(c.apiEndpoint(ApplicationByIdEndpoint, id), request.NetworkItemId, request.NetworkItemType)
+
+	application := new(Application)
+	err := c.newRequestJSON(ctx, "PUT", endpoint.String(), request.internalRequest(), application)
+	if err != nil {
+		return nil, err
+	}
+
+	return application, nil
+}
+
+func (c *Client) DeleteApplication(ctx context.Context, id string) error {
+	return c.newRequest(ctx, "DELETE", c.apiEndpoint(ApplicationByIdEndpoint, id), nil, nil)
+}
+
+func (r *ApplicationData) internalRequest() *ApplicationRequest {
+	return &ApplicationRequest{
+		Name:        r.Name,
+		Description: r.Description,
+		Routes:      r.Routes,
+		Config:      r.Config,
+	}
+}
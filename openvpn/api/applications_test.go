@@ -0,0 +1,131 @@
+package api
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetApplication(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	authConfig := getAuthConfigTestData()
+	ctx := context.Background()
+	authData := &AuthData{AccessToken: "AccessToken"}
+
+	t.Run("non-authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
+
+		// when
+		_, err := client.GetApplication(ctx, "123")
+
+		// then
+		assert.Error(t, err)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+
+		expectedApplication := &Application{
+			ID:              "application-id-123",
+			Name:            "wiki",
+			NetworkItemId:   "network-id",
+			NetworkItemType: NetworkItemTypeNetwork,
+			Routes:          []ApplicationRoute{{Value: "wiki.example.com", AllowEmbeddedIp: true}},
+			Config:          &ServiceConfig{ServiceTypes: []string{"HTTPS"}},
+		}
+
+		mockHttpClient.mockDo(t, expectedApplication, func(request *http.Request) {
+			assertRequestAuthorizedWithToken(t, request, authData.AccessToken)
+			assert.Equal(t, "GET", request.Method)
+			assert.True(t, strings.HasSuffix(request.URL.Path, ApplicationsEndpoint+"/"+expectedApplication.ID))
+		})
+
+		// when
+		application, err := client.GetApplication(ctx, expectedApplication.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, expectedApplication, application)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestClient_CreateApplication(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	request := &ApplicationData{
+		Name:            "wiki",
+		NetworkItemId:   "host-id",
+		NetworkItemType: NetworkItemTypeHost,
+		Routes:          []ApplicationRoute{{Value: "wiki.example.com", AllowEmbeddedIp: true}},
+	}
+	response := &Application{ID: "application-id", Name: request.Name}
+
+	mockHttpClient.mockDo(t, response, func(httpRequest *http.Request) {
+		assert.Equal(t, "POST", httpRequest.Method)
+		assert.True(t, strings.HasSuffix(httpRequest.URL.Path, ApplicationsEndpoint))
+		assert.Equal(t, request.NetworkItemId, httpRequest.URL.Query().Get("networkItemId"))
+		assert.Equal(t, string(request.NetworkItemType), httpRequest.URL.Query().Get("networkItemType"))
+
+		body := &ApplicationRequest{}
+		decodeRequestBody(t, httpRequest, body)
+		assert.Equal(t, request.internalRequest(), body)
+	})
+
+	application, err := client.CreateApplication(context.Background(), request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, response, application)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestClient_UpdateApplication(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	request := &ApplicationData{
+		Name:            "wiki",
+		NetworkItemId:   "host-id",
+		NetworkItemType: NetworkItemTypeHost,
+		Routes:          []ApplicationRoute{{Value: "docs.example.com"}},
+	}
+	response := &Application{ID: "application-id", Name: request.Name}
+
+	mockHttpClient.mockDo(t, response, func(httpRequest *http.Request) {
+		assert.Equal(t, "PUT", httpRequest.Method)
+		assert.True(t, strings.HasSuffix(httpRequest.URL.Path, response.ID))
+		assert.Equal(t, request.NetworkItemId, httpRequest.URL.Query().Get("networkItemId"))
+	})
+
+	application, err := client.UpdateApplication(context.Background(), response.ID, request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, response, application)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestClient_DeleteApplication(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	mockHttpClient.mockDo(t, nil, func(request *http.Request) {
+		assert.Equal(t, "DELETE", request.Method)
+		assert.True(t, strings.HasSuffix(request.URL.Path, "application-id"))
+	})
+
+	err := client.DeleteApplication(context.Background(), "application-id")
+
+	assert.NoError(t, err)
+	mockHttpClient.AssertExpectations(t)
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 )
@@ -12,6 +13,7 @@ type AuthConfig struct {
 	Host         string
 	ClientID     string
 	ClientSecret string
+	AccessToken  string
 }
 
 type AuthData struct {
@@ -20,7 +22,44 @@ type AuthData struct {
 
 const TokenEndpoint = "/oauth/token"
 
+var (
+	ErrAccessTokenRejected = errors.New("access token was rejected")
+	ErrInvalidCredentials  = errors.New("invalid client credentials")
+	ErrMissingCredentials  = errors.New("missing credentials: set client_id and client_secret, access_token or a credentials profile")
+)
+
 func (c *Client) Authenticate(ctx context.Context) error {
+	c.authMutex.Lock()
+	defer c.authMutex.Unlock()
+
+	return c.authenticate(ctx)
+}
+
+// ensureAuthenticated authenticates the client on the first API call, so that
+// configuring the provider does not require reaching the API.
+func (c *Client) ensureAuthenticated(ctx context.Context) (*AuthData, error) {
+	c.authMutex.Lock()
+	defer c.authMutex.Unlock()
+
+	if c.authData == nil {
+		err := c.authenticate(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("authentication failed: %w", err)
+		}
+	}
+	return c.authData, nil
+}
+
+func (c *Client) authenticate(ctx context.Context) error {
+	if c.authConfig.AccessToken != "" {
+		c.authData = &AuthData{AccessToken: c.authConfig.AccessToken}
+		return nil
+	}
+
+	if c.authConfig.ClientID == "" || c.authConfig.ClientSecret == "" {
+		return ErrMissingCredentials
+	}
+
 	authData, err := authenticationRequest(ctx, c.client, c.authConfig)
 	if err != nil {
 		return err
@@ -30,14 +69,19 @@ func (c *Client) Authenticate(ctx context.Context) error {
 }
 
 func authenticationRequest(ctx context.Context, httpClient HttpClient, authConfig *AuthConfig) (*AuthData, error) {
-	request, err := createAuthRequest(authConfig)
+	request, err := createAuthRequest(ctx, authConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	response, err := httpClient.Do(request.WithContext(ctx))
+	response, err := httpClient.Do(request)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("unable to reach %s: %w", authConfig.Host, err)
+	}
+
+	if response.StatusCode == http.StatusUnauthorized {
+		closeResponse(response)
+		return nil, fmt.Errorf("%w: %s", ErrInvalidCredentials, response.Status)
 	}
 
 	authResponse := &AuthData{}
@@ -49,7 +93,7 @@ func authenticationRequest(ctx context.Context, httpClient HttpClient, authConfi
 	return authResponse, nil
 }
 
-func createAuthRequest(authConfig *AuthConfig) (*http.Request, error) {
+func createAuthRequest(ctx context.Context, authConfig *AuthConfig) (*http.Request, error) {
 	requestBody := map[string]string{
 		"grant_type": "client_credentials",
 		"scope":      "default",
@@ -58,7 +102,7 @@ func createAuthRequest(authConfig *AuthConfig) (*http.Request, error) {
 	if err != nil {
 		return nil, err
 	}
-	request, err := http.NewRequest("POST", authConfig.apiUrl(TokenEndpoint), bytes.NewBuffer(requestBodyBytes))
+	request, err := http.NewRequestWithContext(ctx, "POST", authConfig.apiUrl(TokenEndpoint), bytes.NewBuffer(requestBodyBytes))
 	if err != nil {
 		return nil, err
 	}
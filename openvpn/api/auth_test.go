@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"os"
+	"strings"
 	"testing"
 	"time"
 )
@@ -43,6 +44,89 @@ func TestClient_Authenticate(t *testing.T) {
 	assert.Equal(t, mockResponseBody, client.authData)
 }
 
+func TestClient_AuthenticatesOnFirstRequest(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	ctx := context.Background()
+
+	mockHttpClient.mockDo(t, &AuthData{AccessToken: "LazyToken"}, func(request *http.Request) {
+		assert.True(t, strings.HasSuffix(request.URL.Path, TokenEndpoint))
+	}).Once()
+	mockHttpClient.mockDo(t, []Region{{ID: "us-west-2"}}, func(request *http.Request) {
+		assertRequestAuthorizedWithToken(t, request, "LazyToken")
+	}).Once()
+
+	assert.False(t, client.IsAuthenticated())
+
+	regions, err := client.ListRegions(ctx)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []Region{{ID: "us-west-2"}}, regions)
+	assert.True(t, client.IsAuthenticated())
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestClient_MissingCredentials(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, &AuthConfig{Host: "https://test.openvpn.com"})
+
+	_, err := client.ListRegions(context.Background())
+
+	assert.ErrorIs(t, err, ErrMissingCredentials)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestClient_Authenticate_InvalidCredentials(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+
+	mockHttpClient.mockDoStatus(t, http.StatusUnauthorized, nil, nil)
+
+	err := client.Authenticate(context.Background())
+
+	assert.ErrorIs(t, err, ErrInvalidCredentials)
+	assert.False(t, client.IsAuthenticated())
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestClient_Authenticate_AccessToken(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	authConfig := getAuthConfigTestData()
+	authConfig.AccessToken = "PreIssuedToken"
+	ctx := context.Background()
+
+	t.Run("skips token exchange", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+
+		// when
+		err := client.Authenticate(ctx)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, &AuthData{AccessToken: "PreIssuedToken"}, client.authData)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("rejected token", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		err := client.Authenticate(ctx)
+		require.NoError(t, err)
+
+		mockHttpClient.mockDoStatus(t, http.StatusUnauthorized, nil, func(request *http.Request) {
+			assertRequestAuthorizedWithToken(t, request, "PreIssuedToken")
+		})
+
+		// when
+		_, err = client.GetHost(ctx, "host-id")
+
+		// then
+		assert.ErrorIs(t, err, ErrAccessTokenRejected)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
 func TestClient_Authenticate_Real(t *testing.T) {
 	authConfig, err := getAuthConfig()
 	require.NoError(t, err)
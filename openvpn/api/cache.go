@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const DefaultCacheTTL = 5 * time.Minute
+
+// cache keeps read-mostly API data for the duration of a provider run.
+// Concurrent lookups of a missing key share a single load.
+type cache struct {
+	mutex   sync.Mutex
+	ttl     time.Duration
+	entries map[string]cacheEntry
+	loads   map[string]*cacheLoad
+	now     func() time.Time
+}
+
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+type cacheLoad struct {
+	done     chan struct{}
+	value    interface{}
+	err      error
+	canceled bool
+}
+
+func newCache(ttl time.Duration) *cache {
+	return &cache{
+		ttl:     ttl,
+		entries: map[string]cacheEntry{},
+		loads:   map[string]*cacheLoad{},
+		now:     time.Now,
+	}
+}
+
+// getOrLoad returns the cached value of the key or loads it with the context of the caller. Callers waiting for
+// the load of another caller stop waiting when their own context is done and load again themselves when the
+// context of the loading caller was canceled.
+func (c *cache) getOrLoad(ctx context.Context, key string, load func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	for {
+		c.mutex.Lock()
+		if entry, ok := c.entries[key]; ok && c.now().Before(entry.expires) {
+			c.mutex.Unlock()
+			return entry.value, nil
+		}
+		pending, ok := c.loads[key]
+		if !ok {
+			pending = &cacheLoad{done: make(chan struct{})}
+			c.loads[key] = pending
+			c.mutex.Unlock()
+			return c.load(ctx, key, pending, load)
+		}
+		c.mutex.Unlock()
+
+		select {
+		case <-pending.done:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		if !pending.canceled {
+			return pending.value, pending.err
+		}
+	}
+}
+
+// load runs the load of a pending entry and releases its waiters, also when the load panics.
+func (c *cache) load(ctx context.Context, key string, pending *cacheLoad, load func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	completed := false
+	defer func() {
+		if !completed {
+			pending.err = fmt.Errorf("loading %s panicked", key)
+		}
+		c.mutex.Lock()
+		delete(c.loads, key)
+		if pending.err == nil {
+			c.entries[key] = cacheEntry{value: pending.value, expires: c.now().Add(c.ttl)}
+		}
+		c.mutex.Unlock()
+		close(pending.done)
+	}()
+
+	pending.value, pending.err = load(ctx)
+	pending.canceled = pending.err != nil && ctx.Err() != nil
+	completed = true
+
+	return pending.value, pending.err
+}
+
+func (c *cache) set(key string, value interface{}) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries[key] = cacheEntry{value: value, expires: c.now().Add(c.ttl)}
+}
+
+func (c *cache) delete(key string) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	delete(c.entries, key)
+}
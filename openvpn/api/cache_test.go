@@ -0,0 +1,179 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCache_getOrLoad(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("expires after ttl", func(t *testing.T) {
+		// given
+		now := time.Now()
+		c := newCache(time.Minute)
+		c.now = func() time.Time { return now }
+		loads := 0
+		load := func(ctx context.Context) (interface{}, error) {
+			loads++
+			return loads, nil
+		}
+
+		// when
+		first, _ := c.getOrLoad(ctx, "key", load)
+		cached, _ := c.getOrLoad(ctx, "key", load)
+		now = now.Add(2 * time.Minute)
+		expired, _ := c.getOrLoad(ctx, "key", load)
+
+		// then
+		assert.Equal(t, 1, first)
+		assert.Equal(t, 1, cached)
+		assert.Equal(t, 2, expired)
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		// given
+		c := newCache(time.Minute)
+
+		// when
+		_, err := c.getOrLoad(ctx, "key", func(ctx context.Context) (interface{}, error) {
+			return nil, errors.New("failed")
+		})
+		value, retryErr := c.getOrLoad(ctx, "key", func(ctx context.Context) (interface{}, error) {
+			return "value", nil
+		})
+
+		// then
+		assert.Error(t, err)
+		assert.NoError(t, retryErr)
+		assert.Equal(t, "value", value)
+	})
+
+	t.Run("concurrent lookups share one load", func(t *testing.T) {
+		// given
+		c := newCache(time.Minute)
+		var loads int32
+		release := make(chan struct{})
+		load := func(ctx context.Context) (interface{}, error) {
+			atomic.AddInt32(&loads, 1)
+			<-release
+			return "value", nil
+		}
+
+		// when
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				value, err := c.getOrLoad(ctx, "key", load)
+				assert.NoError(t, err)
+				assert.Equal(t, "value", value)
+			}()
+		}
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		// then
+		assert.Equal(t, int32(1), atomic.LoadInt32(&loads))
+	})
+
+	t.Run("waiters load again when the loading caller is canceled", func(t *testing.T) {
+		// given
+		c := newCache(time.Minute)
+		loaderCtx, cancel := context.WithCancel(ctx)
+		started := make(chan struct{})
+		go func() {
+			_, _ = c.getOrLoad(loaderCtx, "key", func(ctx context.Context) (interface{}, error) {
+				close(started)
+				<-ctx.Done()
+				return nil, ctx.Err()
+			})
+		}()
+		<-started
+
+		// when
+		result := make(chan interface{})
+		go func() {
+			value, err := c.getOrLoad(ctx, "key", func(ctx context.Context) (interface{}, error) {
+				return "value", nil
+			})
+			assert.NoError(t, err)
+			result <- value
+		}()
+		time.Sleep(10 * time.Millisecond)
+		cancel()
+
+		// then
+		assert.Equal(t, "value", <-result)
+	})
+
+	t.Run("waiters stop waiting when their context is done", func(t *testing.T) {
+		// given
+		c := newCache(time.Minute)
+		release := make(chan struct{})
+		defer close(release)
+		started := make(chan struct{})
+		go func() {
+			_, _ = c.getOrLoad(ctx, "key", func(ctx context.Context) (interface{}, error) {
+				close(started)
+				<-release
+				return "value", nil
+			})
+		}()
+		<-started
+		waiterCtx, cancel := context.WithCancel(ctx)
+		cancel()
+
+		// when
+		_, err := c.getOrLoad(waiterCtx, "key", func(ctx context.Context) (interface{}, error) {
+			return "other", nil
+		})
+
+		// then
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+
+	t.Run("panicking load releases waiters", func(t *testing.T) {
+		// given
+		c := newCache(time.Minute)
+		started := make(chan struct{})
+		release := make(chan struct{})
+		go func() {
+			defer func() {
+				assert.NotNil(t, recover())
+			}()
+			_, _ = c.getOrLoad(ctx, "key", func(ctx context.Context) (interface{}, error) {
+				close(started)
+				<-release
+				panic("load failed")
+			})
+		}()
+		<-started
+
+		// when
+		result := make(chan error)
+		go func() {
+			_, err := c.getOrLoad(ctx, "key", func(ctx context.Context) (interface{}, error) {
+				return nil, errors.New("loaded by the waiter")
+			})
+			result <- err
+		}()
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+
+		// then
+		assert.EqualError(t, <-result, "loading key panicked")
+		value, err := c.getOrLoad(ctx, "key", func(ctx context.Context) (interface{}, error) {
+			return "value", nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, "value", value)
+	})
+}
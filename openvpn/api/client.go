@@ -4,17 +4,22 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"net/http"
+	"sync"
 )
 
 type Client struct {
 	client     HttpClient
 	authData   *AuthData
 	authConfig *AuthConfig
+	authMutex  sync.Mutex
+
+	regionsCache  *cache
+	profilesCache *cache
+	userLocks     userLocks
 }
 
 type HttpClient interface {
@@ -31,47 +36,50 @@ type ErrorResponse struct {
 }
 
 func NewClient(client HttpClient, authConfig *AuthConfig) *Client {
-	return &Client{client: client, authConfig: authConfig}
+	return &Client{
+		client:        client,
+		authConfig:    authConfig,
+		regionsCache:  newCache(DefaultCacheTTL),
+		profilesCache: newCache(DefaultCacheTTL),
+	}
 }
 
-func processJsonResponse(response *http.Response, body interface{}) error {
-	err := processResponseError(response)
-	if err != nil {
-		return err
-	}
+// maxDrainBytes limits how much of an unread response body is discarded
+// so the underlying connection can be reused.
+const maxDrainBytes = 64 << 10
 
-	err = json.NewDecoder(response.Body).Decode(body)
-	if err != nil {
-		return err
-	}
+func processJsonResponse(response *http.Response, body interface{}) error {
+	defer closeResponse(response)
 
-	err = response.Body.Close()
+	err := processResponseError(response)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	return json.NewDecoder(response.Body).Decode(body)
 }
 
 func (c *Client) getBytesResponse(response *http.Response) ([]byte, error) {
+	defer closeResponse(response)
+
 	err := processResponseError(response)
 	if err != nil {
 		return nil, err
 	}
 
-	data, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		return nil, err
-	}
+	return ioutil.ReadAll(response.Body)
+}
 
-	err = response.Body.Close()
-	if err != nil {
-		return nil, err
+// closeResponse drains and closes the response body. It is safe to call more than once.
+func closeResponse(response *http.Response) {
+	if response == nil || response.Body == nil {
+		return
 	}
-
-	return data, nil
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(response.Body, maxDrainBytes))
+	_ = response.Body.Close()
 }
 
+// processResponseError turns an error status into an error. The caller is responsible for closing the body.
 func processResponseError(response *http.Response) error {
 	if response.StatusCode >= 400 {
 		errorBody := &ErrorResponse{}
@@ -85,11 +93,13 @@ func processResponseError(response *http.Response) error {
 	return nil
 }
 
-func (c Client) apiEndpoint(format string, a ...interface{}) string {
+func (c *Client) apiEndpoint(format string, a ...interface{}) string {
 	return c.authConfig.apiUrl(format, a...)
 }
 
-func (c Client) IsAuthenticated() bool {
+func (c *Client) IsAuthenticated() bool {
+	c.authMutex.Lock()
+	defer c.authMutex.Unlock()
 	return c.authData != nil
 }
 
@@ -115,30 +125,35 @@ func (c *Client) newRequest(ctx context.Context, method, url string, reqBodyRead
 		return err
 	}
 
-	if resBody != nil {
-		err = processJsonResponse(response, resBody)
-		if err != nil {
-			return err
-		}
+	if resBody == nil {
+		closeResponse(response)
+		return nil
 	}
 
-	return nil
+	return processJsonResponse(response, resBody)
 }
 
 func (c *Client) newRequestWithResponse(ctx context.Context, method string, url string, reqBodyReader io.Reader) (*http.Response, error) {
-	if !c.IsAuthenticated() {
-		return nil, errors.New("authentication is required")
+	authData, err := c.ensureAuthenticated(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	request, err := http.NewRequest(method, url, reqBodyReader)
+	request, err := http.NewRequestWithContext(ctx, method, url, reqBodyReader)
 	if err != nil {
 		return nil, err
 	}
-	c.authData.AuthorizeRequest(request)
+	authData.AuthorizeRequest(request)
 
-	response, err := c.client.Do(request.WithContext(ctx))
+	response, err := c.client.Do(request)
 	if err != nil {
 		return nil, err
 	}
+
+	if response.StatusCode == http.StatusUnauthorized && c.authConfig.AccessToken != "" {
+		closeResponse(response)
+		return nil, fmt.Errorf("%w: %s %s %s", ErrAccessTokenRejected, method, request.URL.Path, response.Status)
+	}
+
 	return response, nil
 }
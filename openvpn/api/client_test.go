@@ -0,0 +1,244 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type trackingBody struct {
+	*strings.Reader
+	closed bool
+}
+
+func (b *trackingBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func (m *mockHttpClient) mockDoTracked(statusCode int, responseBody string) *trackingBody {
+	body := &trackingBody{Reader: strings.NewReader(responseBody)}
+	response := &http.Response{
+		StatusCode: statusCode,
+		Status:     http.StatusText(statusCode),
+		Body:       body,
+		Request:    httptest.NewRequest("GET", "/", nil),
+	}
+	m.On("Do", mock.AnythingOfType("*http.Request")).Return(response, nil).Once()
+	return body
+}
+
+func TestClient_RequestCancellation(t *testing.T) {
+	t.Run("api request", func(t *testing.T) {
+		// given
+		started := make(chan struct{})
+		cancelled := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			close(started)
+			select {
+			case <-request.Context().Done():
+				close(cancelled)
+			case <-time.After(10 * time.Second):
+			}
+		}))
+		defer server.Close()
+
+		client := NewClient(server.Client(), &AuthConfig{Host: server.URL, AccessToken: "AccessToken"})
+		ctx, cancel := context.WithCancel(context.Background())
+		go func() {
+			<-started
+			cancel()
+		}()
+
+		// when
+		begin := time.Now()
+		_, err := client.ListRegions(ctx)
+
+		// then
+		assert.True(t, errors.Is(err, context.Canceled), "unexpected error: %v", err)
+		assert.Less(t, int64(time.Since(begin)), int64(5*time.Second))
+		select {
+		case <-cancelled:
+		case <-time.After(5 * time.Second):
+			t.Fatal("server did not observe the cancellation")
+		}
+	})
+
+	t.Run("authentication", func(t *testing.T) {
+		// given
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			// the server only notices a closed connection once the request body has been consumed
+			_, _ = ioutil.ReadAll(request.Body)
+			<-request.Context().Done()
+		}))
+		defer server.Close()
+
+		client := NewClient(server.Client(), &AuthConfig{Host: server.URL, ClientID: "id", ClientSecret: "secret"})
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+
+		// when
+		_, err := client.ListRegions(ctx)
+
+		// then
+		assert.True(t, errors.Is(err, context.DeadlineExceeded), "unexpected error: %v", err)
+		assert.False(t, client.IsAuthenticated())
+	})
+
+	t.Run("already cancelled", func(t *testing.T) {
+		// given
+		var requests int32
+		server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+			atomic.AddInt32(&requests, 1)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.Client(), &AuthConfig{Host: server.URL, AccessToken: "AccessToken"})
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// when
+		err := client.DeleteHost(ctx, "host_id")
+
+		// then
+		assert.True(t, errors.Is(err, context.Canceled), "unexpected error: %v", err)
+		assert.Equal(t, int32(0), atomic.LoadInt32(&requests))
+	})
+}
+
+func TestClient_ResponseBodyClosed(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("error status", func(t *testing.T) {
+		// given
+		mockHttpClient := newMockHttpClient()
+		client := NewClient(mockHttpClient, &AuthConfig{Host: "https://example.com", AccessToken: "AccessToken"})
+		body := mockHttpClient.mockDoTracked(http.StatusInternalServerError, `{"status":500}`)
+
+		// when
+		_, err := client.GetHost(ctx, "host_id")
+
+		// then
+		assert.Error(t, err)
+		assert.True(t, body.closed)
+	})
+
+	t.Run("malformed error body", func(t *testing.T) {
+		// given
+		mockHttpClient := newMockHttpClient()
+		client := NewClient(mockHttpClient, &AuthConfig{Host: "https://example.com", AccessToken: "AccessToken"})
+		body := mockHttpClient.mockDoTracked(http.StatusBadGateway, `<html>`)
+
+		// when
+		_, err := client.GetConnectorProfile(ctx, "connector_id")
+
+		// then
+		assert.Error(t, err)
+		assert.True(t, body.closed)
+	})
+
+	t.Run("malformed body", func(t *testing.T) {
+		// given
+		mockHttpClient := newMockHttpClient()
+		client := NewClient(mockHttpClient, &AuthConfig{Host: "https://example.com", AccessToken: "AccessToken"})
+		body := mockHttpClient.mockDoTracked(http.StatusOK, `{"id":`)
+
+		// when
+		_, err := client.GetHost(ctx, "host_id")
+
+		// then
+		assert.Error(t, err)
+		assert.True(t, body.closed)
+	})
+
+	t.Run("ignored body", func(t *testing.T) {
+		// given
+		mockHttpClient := newMockHttpClient()
+		client := NewClient(mockHttpClient, &AuthConfig{Host: "https://example.com", AccessToken: "AccessToken"})
+		body := mockHttpClient.mockDoTracked(http.StatusNoContent, "")
+
+		// when
+		err := client.DeleteHost(ctx, "host_id")
+
+		// then
+		assert.NoError(t, err)
+		assert.True(t, body.closed)
+	})
+
+	t.Run("rejected access token", func(t *testing.T) {
+		// given
+		mockHttpClient := newMockHttpClient()
+		client := NewClient(mockHttpClient, &AuthConfig{Host: "https://example.com", AccessToken: "AccessToken"})
+		body := mockHttpClient.mockDoTracked(http.StatusUnauthorized, "")
+
+		// when
+		_, err := client.GetHost(ctx, "host_id")
+
+		// then
+		assert.True(t, errors.Is(err, ErrAccessTokenRejected))
+		assert.True(t, body.closed)
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		// given
+		mockHttpClient := newMockHttpClient()
+		client := NewClient(mockHttpClient, getAuthConfigTestData())
+		body := mockHttpClient.mockDoTracked(http.StatusUnauthorized, "")
+
+		// when
+		err := client.Authenticate(ctx)
+
+		// then
+		assert.True(t, errors.Is(err, ErrInvalidCredentials))
+		assert.True(t, body.closed)
+	})
+}
+
+func TestClient_ReusesConnectionsAfterErrors(t *testing.T) {
+	// given
+	var connections int32
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusNotFound)
+		_, _ = writer.Write([]byte(`{"status":404,"statusError":"NOT_FOUND"}`))
+	}))
+	server.Config.ConnState = func(conn net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	client := NewClient(server.Client(), &AuthConfig{Host: server.URL, AccessToken: "AccessToken"})
+
+	// when
+	for i := 0; i < 5; i++ {
+		_, err := client.GetHost(context.Background(), "host_id")
+		require.Error(t, err)
+	}
+
+	// then
+	assert.Equal(t, int32(1), atomic.LoadInt32(&connections))
+}
+
+func TestCloseResponse(t *testing.T) {
+	body := &trackingBody{Reader: strings.NewReader("unread")}
+	response := &http.Response{Body: body}
+
+	closeResponse(response)
+	closeResponse(nil)
+
+	rest, _ := ioutil.ReadAll(body.Reader)
+	assert.Empty(t, rest)
+	assert.True(t, body.closed)
+}
@@ -68,7 +68,7 @@ func (c *Client) GetConnector(ctx context.Context, connectorId string) (*Connect
 
 func (c *Client) CreateConnector(ctx context.Context, request *CreateConnectorData) (*Connector, error) {
 
-	createConnectorUrl := c.createConnectorUrl(c.apiEndpoint(ConnectorsEndpoint), request.NetworkItemId, request.NetworkItemType)
+	createConnectorUrl := c.networkItemUrl(c.apiEndpoint(ConnectorsEndpoint), request.NetworkItemId, request.NetworkItemType)
 
 	connector := new(Connector)
 	err := c.newRequestJSON(ctx, "POST", createConnectorUrl.String(), request.internalRequest(), connector)
@@ -80,7 +80,7 @@ func (c *Client) CreateConnector(ctx context.Context, request *CreateConnectorDa
 }
 
 func (c *Client) UpdateConnector(ctx context.Context, connectorID string, request *CreateConnectorData) (*Connector, error) {
-	createConnectorUrl := c.createConnectorUrl(c.apiEndpoint(ConnectorByIdEndpoint, connectorID), request.NetworkItemId, request.NetworkItemType)
+	createConnectorUrl := c.networkItemUrl(c.apiEndpoint(ConnectorByIdEndpoint, connectorID), request.NetworkItemId, request.NetworkItemType)
 
 	connector := new(Connector)
 	err := c.newRequestJSON(ctx, "PUT", createConnectorUrl.String(), request.internalRequest(), connector)
@@ -92,17 +92,39 @@ func (c *Client) UpdateConnector(ctx context.Context, connectorID string, reques
 }
 
 func (c *Client) DeleteConnector(ctx context.Context, networkItemId string, networkItemType NetworkItemType, connectorId string) error {
-	endpoint := c.createConnectorUrl(c.apiEndpoint(ConnectorByIdEndpoint, connectorId), networkItemId, networkItemType)
+	endpoint := c.networkItemUrl(c.apiEndpoint(ConnectorByIdEndpoint, connectorId), networkItemId, networkItemType)
 
 	err := c.newRequest(ctx, "DELETE", endpoint.String(), nil, nil)
 	if err != nil {
 		return err
 	}
 
+	c.profilesCache.delete(connectorId)
 	return nil
 }
 
+// GetConnectorProfile returns the profile of the connector, profiles are cached per connector for DefaultCacheTTL.
 func (c *Client) GetConnectorProfile(ctx context.Context, connectorId string) (string, error) {
+	profile, err := c.profilesCache.getOrLoad(ctx, connectorId, func(ctx context.Context) (interface{}, error) {
+		return c.generateConnectorProfile(ctx, connectorId)
+	})
+	if err != nil {
+		return "", err
+	}
+	return profile.(string), nil
+}
+
+// RegenerateConnectorProfile always requests a new profile for the connector, bypassing the cache.
+func (c *Client) RegenerateConnectorProfile(ctx context.Context, connectorId string) (string, error) {
+	profile, err := c.generateConnectorProfile(ctx, connectorId)
+	if err != nil {
+		return "", err
+	}
+	c.profilesCache.set(connectorId, profile)
+	return profile, nil
+}
+
+func (c *Client) generateConnectorProfile(ctx context.Context, connectorId string) (string, error) {
 	endpoint := c.apiEndpoint(ConnectorProfileByIdEndpoint, connectorId)
 	response, err := c.newRequestWithResponse(ctx, "POST", endpoint, bytes.NewBufferString(""))
 	if err != nil {
@@ -117,15 +139,16 @@ func (c *Client) GetConnectorProfile(ctx context.Context, connectorId string) (s
 	return string(data), nil
 }
 
-func (c *Client) createConnectorUrl(rawUrl, networkItemId string, networkItemType NetworkItemType) *url.URL {
-	createConnectorUrl, _ := url.Parse(rawUrl)
+// networkItemUrl adds the host or network an item belongs to as query parameters to the url.
+func (c *Client) networkItemUrl(rawUrl, networkItemId string, networkItemType NetworkItemType) *url.URL {
+	itemUrl, _ := url.Parse(rawUrl)
 
-	query := createConnectorUrl.Query()
+	query := itemUrl.Query()
 	query.Set("networkItemId", networkItemId)
 	query.Set("networkItemType", string(networkItemType))
-	createConnectorUrl.RawQuery = query.Encode()
+	itemUrl.RawQuery = query.Encode()
 
-	return createConnectorUrl
+	return itemUrl
 }
 
 func (t NetworkItemType) Validate() error {
@@ -21,6 +21,7 @@ func TestClient_GetConnector(t *testing.T) {
 	t.Run("non-authenticated", func(t *testing.T) {
 		// given
 		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
 
 		// when
 		_, err := client.GetConnector(ctx, "123")
@@ -70,6 +71,7 @@ func TestClient_DeleteConnector(t *testing.T) {
 	t.Run("non-authenticated", func(t *testing.T) {
 		// given
 		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
 
 		// when
 		err := client.DeleteConnector(ctx, "networkId123", NetworkItemTypeHost, "connectorID")
@@ -113,6 +115,7 @@ func TestClient_CreateConnector(t *testing.T) {
 	t.Run("non-authenticated", func(t *testing.T) {
 		// given
 		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
 
 		createConnectorRequest := &CreateConnectorData{
 			Name: "name123",
@@ -178,6 +181,7 @@ func TestClient_UpdateConnector(t *testing.T) {
 	t.Run("non-authenticated", func(t *testing.T) {
 		// given
 		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
 
 		// when
 		_, err := client.UpdateConnector(ctx, "123", updateConnectorRequest)
@@ -232,6 +236,7 @@ func TestClient_GetConnectorProfile(t *testing.T) {
 	t.Run("non-authenticated", func(t *testing.T) {
 		// given
 		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
 
 		// when
 		_, err := client.GetConnectorProfile(ctx, "123")
@@ -264,6 +269,34 @@ func TestClient_GetConnectorProfile(t *testing.T) {
 	})
 }
 
+func TestClient_GetConnectorProfile_cached(t *testing.T) {
+	// given
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+	ctx := context.Background()
+
+	mockHttpClient.mockDoBytes(t, []byte("first profile"), nil).Once()
+	mockHttpClient.mockDoBytes(t, []byte("regenerated profile"), nil).Once()
+
+	// when
+	first, err := client.GetConnectorProfile(ctx, "connector-id-123")
+	require.NoError(t, err)
+	cached, err := client.GetConnectorProfile(ctx, "connector-id-123")
+	require.NoError(t, err)
+	regenerated, err := client.RegenerateConnectorProfile(ctx, "connector-id-123")
+	require.NoError(t, err)
+	afterRegeneration, err := client.GetConnectorProfile(ctx, "connector-id-123")
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, "first profile", first)
+	assert.Equal(t, "first profile", cached)
+	assert.Equal(t, "regenerated profile", regenerated)
+	assert.Equal(t, "regenerated profile", afterRegeneration)
+	mockHttpClient.AssertNumberOfCalls(t, "Do", 2)
+}
+
 func TestClient_UpdateConnector_safe_info_Real(t *testing.T) {
 	// setup
 	skipIfNotAcceptance(t)
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+type CredentialsProfile struct {
+	Host         string `json:"host"`
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	AccessToken  string `json:"access_token"`
+}
+
+const (
+	DefaultCredentialsFile = ".openvpn-cloud/credentials"
+	DefaultProfile         = "default"
+)
+
+func DefaultCredentialsFilePath() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, DefaultCredentialsFile), nil
+}
+
+// LoadCredentialsFile reads named credential profiles from a shared credentials file.
+// The file is either a JSON object keyed by profile name or an INI file with one section per profile.
+func LoadCredentialsFile(path string) (map[string]CredentialsProfile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles, err := parseCredentials(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid credentials file %s: %w", path, err)
+	}
+	return profiles, nil
+}
+
+func parseCredentials(data []byte) (map[string]CredentialsProfile, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		profiles := map[string]CredentialsProfile{}
+		err := json.Unmarshal(trimmed, &profiles)
+		if err != nil {
+			return nil, err
+		}
+		return profiles, nil
+	}
+	return parseCredentialsINI(data)
+}
+
+func parseCredentialsINI(data []byte) (map[string]CredentialsProfile, error) {
+	profiles := map[string]CredentialsProfile{}
+	section := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") && strings.HasSuffix(line, "]") {
+			section = strings.TrimSpace(line[1 : len(line)-1])
+			if section == "" {
+				return nil, fmt.Errorf("line %d: empty profile name", lineNumber)
+			}
+			profiles[section] = profiles[section]
+			continue
+		}
+
+		if section == "" {
+			return nil, fmt.Errorf("line %d: value outside of a profile section", lineNumber)
+		}
+
+		parts := strings.SplitN(line, "=", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("line %d: expected 'key = value'", lineNumber)
+		}
+		key := strings.TrimSpace(parts[0])
+		value := unquoteCredentialsValue(strings.TrimSpace(parts[1]))
+
+		profile := profiles[section]
+		switch key {
+		case "host":
+			profile.Host = value
+		case "client_id":
+			profile.ClientID = value
+		case "client_secret":
+			profile.ClientSecret = value
+		case "access_token":
+			profile.AccessToken = value
+		default:
+			return nil, fmt.Errorf("line %d: unknown key '%s'", lineNumber, key)
+		}
+		profiles[section] = profile
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return profiles, nil
+}
+
+// unquoteCredentialsValue removes one pair of matching quotes around the value, quotes inside the value or
+// on one side only are part of it.
+func unquoteCredentialsValue(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
+
+// ApplyProfile fills the values not set explicitly in the config from the given profile.
+func (c *AuthConfig) ApplyProfile(profile CredentialsProfile) {
+	if c.Host == "" {
+		c.Host = profile.Host
+	}
+	if c.ClientID == "" {
+		c.ClientID = profile.ClientID
+	}
+	if c.ClientSecret == "" {
+		c.ClientSecret = profile.ClientSecret
+	}
+	if c.AccessToken == "" {
+		c.AccessToken = profile.AccessToken
+	}
+}
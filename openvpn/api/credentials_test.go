@@ -0,0 +1,127 @@
+package api
+
+import (
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadCredentialsFile(t *testing.T) {
+	expectedProfiles := map[string]CredentialsProfile{
+		"default": {
+			Host:         "https://staging.openvpn.com",
+			ClientID:     "staging-id",
+			ClientSecret: "staging-secret",
+		},
+		"prod": {
+			Host:         "https://prod.openvpn.com",
+			ClientID:     "prod-id",
+			ClientSecret: "prod-secret",
+		},
+	}
+
+	t.Run("ini", func(t *testing.T) {
+		// given
+		path := writeCredentialsFile(t, `
+# staging tenant
+[default]
+host = https://staging.openvpn.com
+client_id = staging-id
+client_secret = "staging-secret"
+
+[prod]
+host=https://prod.openvpn.com
+client_id=prod-id
+client_secret=prod-secret
+`)
+
+		// when
+		profiles, err := LoadCredentialsFile(path)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, expectedProfiles, profiles)
+	})
+
+	t.Run("ini quoted values", func(t *testing.T) {
+		// given
+		path := writeCredentialsFile(t, `
+[default]
+client_id = 'single-quoted'
+client_secret = "ends-with-quote'"
+
+[prod]
+client_id = id
+client_secret = secret"
+`)
+
+		// when
+		profiles, err := LoadCredentialsFile(path)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, map[string]CredentialsProfile{
+			"default": {ClientID: "single-quoted", ClientSecret: "ends-with-quote'"},
+			"prod":    {ClientID: "id", ClientSecret: `secret"`},
+		}, profiles)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		// given
+		path := writeCredentialsFile(t, `{
+  "default": {"host": "https://staging.openvpn.com", "client_id": "staging-id", "client_secret": "staging-secret"},
+  "prod": {"host": "https://prod.openvpn.com", "client_id": "prod-id", "client_secret": "prod-secret"}
+}`)
+
+		// when
+		profiles, err := LoadCredentialsFile(path)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, expectedProfiles, profiles)
+	})
+
+	t.Run("invalid ini", func(t *testing.T) {
+		// given
+		path := writeCredentialsFile(t, "client_id = outside-of-section\n")
+
+		// when
+		_, err := LoadCredentialsFile(path)
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		// when
+		_, err := LoadCredentialsFile(filepath.Join(t.TempDir(), "credentials"))
+
+		// then
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestAuthConfig_ApplyProfile(t *testing.T) {
+	authConfig := &AuthConfig{ClientID: "explicit-id"}
+
+	authConfig.ApplyProfile(CredentialsProfile{
+		Host:         "https://prod.openvpn.com",
+		ClientID:     "prod-id",
+		ClientSecret: "prod-secret",
+	})
+
+	assert.Equal(t, &AuthConfig{
+		Host:         "https://prod.openvpn.com",
+		ClientID:     "explicit-id",
+		ClientSecret: "prod-secret",
+	}, authConfig)
+}
+
+func writeCredentialsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "credentials")
+	err := os.WriteFile(path, []byte(content), 0600)
+	require.NoError(t, err)
+	return path
+}
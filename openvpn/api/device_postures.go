@@ -0,0 +1,85 @@
+package api
+
+import (
+	"context"
+)
+
+type DevicePosture struct {
+	ID            string         `json:"id,omitempty"`
+	Name          string         `json:"name,omitempty"`
+	Description   string         `json:"description,omitempty"`
+	UserGroupsIds []string       `json:"userGroupsIds,omitempty"`
+	Windows       *DesktopPolicy `json:"windows,omitempty"`
+	MacOS         *DesktopPolicy `json:"macos,omitempty"`
+	Linux         *OSPolicy      `json:"linux,omitempty"`
+	Android       *OSPolicy      `json:"android,omitempty"`
+	IOS           *OSPolicy      `json:"ios,omitempty"`
+}
+
+// OSPolicy allows or blocks devices of an operating system, optionally from a minimal version on.
+type OSPolicy struct {
+	Allowed    bool   `json:"allowed"`
+	MinVersion string `json:"minVersion,omitempty"`
+}
+
+// DesktopPolicy adds the security checks only available on desktop operating systems to OSPolicy.
+type DesktopPolicy struct {
+	Allowed               bool     `json:"allowed"`
+	MinVersion            string   `json:"minVersion,omitempty"`
+	RequireAntivirus      bool     `json:"requireAntivirus"`
+	Antiviruses           []string `json:"antiviruses,omitempty"`
+	RequireDiskEncryption bool     `json:"requireDiskEncryption"`
+}
+
+type CreateDevicePostureRequest struct {
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	UserGroupsIds []string       `json:"userGroupsIds"`
+	Windows       *DesktopPolicy `json:"windows,omitempty"`
+	MacOS         *DesktopPolicy `json:"macos,omitempty"`
+	Linux         *OSPolicy      `json:"linux,omitempty"`
+	Android       *OSPolicy      `json:"android,omitempty"`
+	IOS           *OSPolicy      `json:"ios,omitempty"`
+}
+
+type UpdateDevicePostureRequest CreateDevicePostureRequest
+
+const DevicePosturesEndpoint = "/device-postures"
+const DevicePostureDetailsEndpoint = "/device-postures/%s"
+
+func (c *Client) GetDevicePosture(ctx context.Context, id string) (*DevicePosture, error) {
+	devicePosture := new(DevicePosture)
+
+	err := c.newRequest(ctx, "GET", c.apiEndpoint(DevicePostureDetailsEndpoint, id), nil, devicePosture)
+	if err != nil {
+		return nil, err
+	}
+
+	return devicePosture, nil
+}
+
+func (c *Client) CreateDevicePosture(ctx context.Context, createDevicePostureRequest *CreateDevicePostureRequest) (*DevicePosture, error) {
+	devicePosture := new(DevicePosture)
+
+	err := c.newRequestJSON(ctx, "POST", c.apiEndpoint(DevicePosturesEndpoint), createDevicePostureRequest, devicePosture)
+	if err != nil {
+		return nil, err
+	}
+
+	return devicePosture, nil
+}
+
+func (c *Client) UpdateDevicePosture(ctx context.Context, id string, updateDevicePostureRequest *UpdateDevicePostureRequest) (*DevicePosture, error) {
+	devicePosture := new(DevicePosture)
+
+	err := c.newRequestJSON(ctx, "PUT", c.apiEndpoint(DevicePostureDetailsEndpoint, id), updateDevicePostureRequest, devicePosture)
+	if err != nil {
+		return nil, err
+	}
+
+	return devicePosture, nil
+}
+
+func (c *Client) DeleteDevicePosture(ctx context.Context, id string) error {
+	return c.newRequest(ctx, "DELETE", c.apiEndpoint(DevicePostureDetailsEndpoint, id), nil, nil)
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetDevicePosture(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	authConfig := getAuthConfigTestData()
+	ctx := context.Background()
+	authData := &AuthData{AccessToken: "AccessToken"}
+
+	t.Run("non-authenticated", func(t *testing.T) {
+		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
+		_, err := client.GetDevicePosture(ctx, "123")
+		assert.Error(t, err)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("authenticated", func(t *testing.T) {
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+
+		expectedDevicePosture := &DevicePosture{
+			ID:            "device-posture-id",
+			Name:          "compliant devices",
+			UserGroupsIds: []string{"group-id"},
+			Windows:       &DesktopPolicy{Allowed: true, MinVersion: "10.0.19045", RequireAntivirus: true, RequireDiskEncryption: true},
+			MacOS:         &DesktopPolicy{Allowed: true, Antiviruses: []string{"SOPHOS"}, RequireAntivirus: true},
+			Linux:         &OSPolicy{Allowed: false},
+			Android:       &OSPolicy{Allowed: true, MinVersion: "12"},
+			IOS:           &OSPolicy{Allowed: true, MinVersion: "16.1"},
+		}
+		mockHttpClient.mockDo(t, expectedDevicePosture, func(request *http.Request) {
+			assertRequestAuthorizedWithToken(t, request, authData.AccessToken)
+			assert.True(t, strings.HasSuffix(request.URL.Path, DevicePosturesEndpoint+"/"+expectedDevicePosture.ID))
+		})
+
+		devicePosture, err := client.GetDevicePosture(ctx, expectedDevicePosture.ID)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedDevicePosture, devicePosture)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestClient_CreateDevicePosture(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	authConfig := getAuthConfigTestData()
+	ctx := context.Background()
+	authData := &AuthData{AccessToken: "AccessToken"}
+
+	createDevicePostureRequest := &CreateDevicePostureRequest{
+		Name:          "encrypted laptops",
+		UserGroupsIds: []string{"group-id"},
+		Windows:       &DesktopPolicy{Allowed: true, RequireDiskEncryption: true},
+		Linux:         &OSPolicy{Allowed: false},
+	}
+
+	t.Run("non-authenticated", func(t *testing.T) {
+		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
+		_, err := client.CreateDevicePosture(ctx, createDevicePostureRequest)
+		assert.Error(t, err)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("authenticated", func(t *testing.T) {
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+
+		expectedDevicePosture := &DevicePosture{ID: "device-posture-id", Name: createDevicePostureRequest.Name}
+		mockHttpClient.mockDo(t, expectedDevicePosture, func(request *http.Request) {
+			assertRequestAuthorizedWithToken(t, request, authData.AccessToken)
+			assert.Equal(t, "POST", request.Method)
+			assert.True(t, strings.HasSuffix(request.URL.Path, DevicePosturesEndpoint))
+
+			body := &CreateDevicePostureRequest{}
+			decodeRequestBody(t, request, body)
+			assert.Equal(t, createDevicePostureRequest, body)
+		})
+
+		devicePosture, err := client.CreateDevicePosture(ctx, createDevicePostureRequest)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedDevicePosture, devicePosture)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestClient_UpdateDevicePosture(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	updateDevicePostureRequest := &UpdateDevicePostureRequest{Name: "renamed", IOS: &OSPolicy{Allowed: false}}
+	expectedDevicePosture := &DevicePosture{ID: "device-posture-id", Name: updateDevicePostureRequest.Name}
+
+	mockHttpClient.mockDo(t, expectedDevicePosture, func(request *http.Request) {
+		assert.Equal(t, "PUT", request.Method)
+		assert.True(t, strings.HasSuffix(request.URL.Path, expectedDevicePosture.ID))
+	})
+
+	devicePosture, err := client.UpdateDevicePosture(context.Background(), expectedDevicePosture.ID, updateDevicePostureRequest)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedDevicePosture, devicePosture)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestClient_DeleteDevicePosture(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	mockHttpClient.mockDo(t, nil, func(request *http.Request) {
+		assert.Equal(t, "DELETE", request.Method)
+		assert.True(t, strings.HasSuffix(request.URL.Path, "device-posture-id"))
+	})
+
+	err := client.DeleteDevicePosture(context.Background(), "device-posture-id")
+
+	assert.NoError(t, err)
+	mockHttpClient.AssertExpectations(t)
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+type DeviceStatus string
+
+type Device struct {
+	ID              string       `json:"id"`
+	Name            string       `json:"name"`
+	Description     string       `json:"description"`
+	UserId          string       `json:"userId"`
+	OS              string       `json:"os"`
+	ClientVersion   string       `json:"clientVersion"`
+	IpV4Address     string       `json:"ipV4Address"`
+	IpV6Address     string       `json:"ipV6Address"`
+	LastConnectedAt string       `json:"lastConnectedAt"`
+	Status          DeviceStatus `json:"status"`
+}
+
+type DevicesPage struct {
+	Content       []Device `json:"content"`
+	Page          int      `json:"page"`
+	Size          int      `json:"size"`
+	TotalElements int      `json:"totalElements"`
+	TotalPages    int      `json:"totalPages"`
+}
+
+const (
+	DevicesEndpoint       = "/devices"
+	DeviceByIdEndpoint    = "/devices/%s"
+	DeviceBlockEndpoint   = "/devices/%s/block"
+	DeviceUnblockEndpoint = "/devices/%s/unblock"
+	DevicesPageSize       = 100
+)
+
+const (
+	DeviceStatusActive  DeviceStatus = "ACTIVE"
+	DeviceStatusBlocked DeviceStatus = "BLOCKED"
+)
+
+// ListUserDevices returns all devices of the user, fetching every page.
+func (c *Client) ListUserDevices(ctx context.Context, userId string) ([]Device, error) {
+	devices := []Device{}
+	for page := 0; ; page++ {
+		endpoint, _ := url.Parse(c.apiEndpoint(DevicesEndpoint))
+		query := endpoint.Query()
+		query.Set("userId", userId)
+		query.Set("page", strconv.Itoa(page))
+		query.Set("size", strconv.Itoa(DevicesPageSize))
+		endpoint.RawQuery = query.Encode()
+
+		devicesPage := new(DevicesPage)
+		err := c.newRequest(ctx, "GET", endpoint.String(), nil, devicesPage)
+		if err != nil {
+			return nil, err
+		}
+
+		devices = append(devices, devicesPage.Content...)
+		if page+1 >= devicesPage.TotalPages || len(devicesPage.Content) == 0 {
+			return devices, nil
+		}
+	}
+}
+
+func (c *Client) GetDevice(ctx context.Context, deviceId string) (*Device, error) {
+	device := new(Device)
+	err := c.newRequest(ctx, "GET", c.apiEndpoint(DeviceByIdEndpoint, deviceId), nil, device)
+	if err != nil {
+		return nil, err
+	}
+
+	return device, nil
+}
+
+// BlockDevice prevents the device from connecting until it is unblocked.
+func (c *Client) BlockDevice(ctx context.Context, deviceId string) error {
+	return c.newRequest(ctx, "PUT", c.apiEndpoint(DeviceBlockEndpoint, deviceId), nil, nil)
+}
+
+func (c *Client) UnblockDevice(ctx context.Context, deviceId string) error {
+	return c.newRequest(ctx, "PUT", c.apiEndpoint(DeviceUnblockEndpoint, deviceId), nil, nil)
+}
+
+func (c *Client) DeleteDevice(ctx context.Context, deviceId string) error {
+	return c.newRequest(ctx, "DELETE", c.apiEndpoint(DeviceByIdEndpoint, deviceId), nil, nil)
+}
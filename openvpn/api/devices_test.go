@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_ListUserDevices(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	authConfig := getAuthConfigTestData()
+	ctx := context.Background()
+	authData := &AuthData{AccessToken: "AccessToken"}
+
+	t.Run("non-authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
+
+		// when
+		_, err := client.ListUserDevices(ctx, "user-id")
+
+		// then
+		assert.Error(t, err)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("all pages", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+
+		laptop := Device{ID: "1", Name: "laptop", UserId: "user-id", OS: "macOS", IpV4Address: "100.96.1.2", Status: DeviceStatusActive}
+		phone := Device{ID: "2", Name: "phone", UserId: "user-id", OS: "iOS", Status: DeviceStatusBlocked}
+
+		mockHttpClient.mockDo(t, &DevicesPage{Content: []Device{laptop}, TotalPages: 2}, func(request *http.Request) {
+			assertRequestAuthorizedWithToken(t, request, authData.AccessToken)
+			assert.True(t, strings.HasSuffix(request.URL.Path, DevicesEndpoint))
+			assert.Equal(t, "user-id", request.URL.Query().Get("userId"))
+			assert.Equal(t, "0", request.URL.Query().Get("page"))
+		}).Once()
+		mockHttpClient.mockDo(t, &DevicesPage{Content: []Device{phone}, Page: 1, TotalPages: 2}, func(request *http.Request) {
+			assert.Equal(t, "1", request.URL.Query().Get("page"))
+		}).Once()
+
+		// when
+		devices, err := client.ListUserDevices(ctx, "user-id")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []Device{laptop, phone}, devices)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("no devices", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+		mockHttpClient.mockDo(t, &DevicesPage{}, nil).Once()
+
+		// when
+		devices, err := client.ListUserDevices(ctx, "user-id")
+
+		// then
+		assert.NoError(t, err)
+		assert.Empty(t, devices)
+		assert.NotNil(t, devices)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestClient_GetDevice(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	expectedDevice := &Device{ID: "device-id", Name: "laptop", UserId: "user-id", LastConnectedAt: "2021-12-01T10:00:00Z", Status: DeviceStatusActive}
+	mockHttpClient.mockDo(t, expectedDevice, func(request *http.Request) {
+		assert.Equal(t, "GET", request.Method)
+		assert.True(t, strings.HasSuffix(request.URL.Path, DevicesEndpoint+"/"+expectedDevice.ID))
+	})
+
+	device, err := client.GetDevice(context.Background(), expectedDevice.ID)
+
+	assert.NoError(t, err)
+	assert.Equal(t, expectedDevice, device)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestClient_DeviceActions(t *testing.T) {
+	tests := []struct {
+		name     string
+		call     func(client *Client) error
+		method   string
+		endpoint string
+	}{
+		{"block", func(client *Client) error { return client.BlockDevice(context.Background(), "device-id") }, "PUT", "/devices/device-id/block"},
+		{"unblock", func(client *Client) error { return client.UnblockDevice(context.Background(), "device-id") }, "PUT", "/devices/device-id/unblock"},
+		{"delete", func(client *Client) error { return client.DeleteDevice(context.Background(), "device-id") }, "DELETE", "/devices/device-id"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			// given
+			mockHttpClient := newMockHttpClient()
+			client := NewClient(mockHttpClient, getAuthConfigTestData())
+			client.authData = &AuthData{AccessToken: "AccessToken"}
+
+			mockHttpClient.mockDo(t, nil, func(request *http.Request) {
+				assert.Equal(t, test.method, request.Method)
+				assert.True(t, strings.HasSuffix(request.URL.Path, test.endpoint))
+			})
+
+			// when
+			err := test.call(client)
+
+			// then
+			assert.NoError(t, err)
+			mockHttpClient.AssertExpectations(t)
+		})
+	}
+}
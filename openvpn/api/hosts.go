@@ -2,39 +2,50 @@ package api
 
 import (
 	"context"
-	"github.com/pkg/errors"
+	"fmt"
 	"net/http"
+	"strings"
 )
 
+type InternetAccess string
+
 type Host struct {
-	ID             string      `json:"id,omitempty"`
-	Name           string      `json:"name,omitempty"`
-	Description    string      `json:"description,omitempty"`
-	InternetAccess string      `json:"internetAccess,omitempty"`
-	Domain         string      `json:"domain,omitempty"`
-	Connectors     []Connector `json:"connectors,omitempty"`
-	SystemSubnets  []string    `json:"systemSubnets,omitempty"`
+	ID             string         `json:"id,omitempty"`
+	Name           string         `json:"name,omitempty"`
+	Description    string         `json:"description,omitempty"`
+	InternetAccess InternetAccess `json:"internetAccess,omitempty"`
+	Domain         string         `json:"domain,omitempty"`
+	Connectors     []Connector    `json:"connectors,omitempty"`
+	SystemSubnets  []string       `json:"systemSubnets,omitempty"`
 }
 
 type CreateHostRequest struct {
 	Name           string                   `json:"name"`
 	Description    string                   `json:"description"`
 	Domain         string                   `json:"domain"`
-	InternetAccess string                   `json:"internetAccess"`
+	InternetAccess InternetAccess           `json:"internetAccess"`
 	Connectors     []CreateConnectorRequest `json:"connectors"`
 }
 
 type UpdateHostRequest struct {
-	Name           string `json:"name"`
-	Description    string `json:"description"`
-	Domain         string `json:"domain"`
-	InternetAccess string `json:"internetAccess"`
+	Name           string         `json:"name"`
+	Description    string         `json:"description"`
+	Domain         string         `json:"domain"`
+	InternetAccess InternetAccess `json:"internetAccess"`
 }
 
 const HostsEndpoint = "/hosts"
 const HostsDetailsEndpoint = "/hosts/%s"
 
-func (c Client) GetHost(ctx context.Context, id string) (*Host, error) {
+const (
+	InternetAccessBlocked        InternetAccess = "BLOCKED"
+	InternetAccessGlobalInternet InternetAccess = "GLOBAL_INTERNET"
+	InternetAccessLocal          InternetAccess = "LOCAL"
+)
+
+var InternetAccessPossibleValues = []string{string(InternetAccessBlocked), string(InternetAccessGlobalInternet), string(InternetAccessLocal)}
+
+func (c *Client) GetHost(ctx context.Context, id string) (*Host, error) {
 	host := new(Host)
 
 	err := c.newRequest(ctx, "GET", c.apiEndpoint(HostsDetailsEndpoint, id), nil, host)
@@ -45,7 +56,7 @@ func (c Client) GetHost(ctx context.Context, id string) (*Host, error) {
 	return host, nil
 }
 
-func (c Client) CreateHost(ctx context.Context, createHostRequest *CreateHostRequest) (*Host, error) {
+func (c *Client) CreateHost(ctx context.Context, createHostRequest *CreateHostRequest) (*Host, error) {
 	host := new(Host)
 
 	err := c.newRequestJSON(ctx, "POST", c.apiEndpoint(HostsEndpoint), createHostRequest, host)
@@ -56,7 +67,7 @@ func (c Client) CreateHost(ctx context.Context, createHostRequest *CreateHostReq
 	return host, nil
 }
 
-func (c Client) UpdateHost(ctx context.Context, id string, updateHostRequest *UpdateHostRequest) (*Host, error) {
+func (c *Client) UpdateHost(ctx context.Context, id string, updateHostRequest *UpdateHostRequest) (*Host, error) {
 	host := new(Host)
 
 	err := c.newRequestJSON(ctx, "PUT", c.apiEndpoint(HostsDetailsEndpoint, id), updateHostRequest, host)
@@ -67,14 +78,25 @@ func (c Client) UpdateHost(ctx context.Context, id string, updateHostRequest *Up
 	return host, nil
 }
 
-func (c Client) DeleteHost(ctx context.Context, id string) error {
+func (c *Client) DeleteHost(ctx context.Context, id string) error {
 	return c.newRequest(ctx, "DELETE", c.apiEndpoint(HostsDetailsEndpoint, id), nil, nil)
 }
 
-func (c Client) AuthorizeRequest(request *http.Request) error {
-	if !c.IsAuthenticated() {
-		return errors.New("authentication is required")
+func (c *Client) AuthorizeRequest(request *http.Request) error {
+	authData, err := c.ensureAuthenticated(request.Context())
+	if err != nil {
+		return err
 	}
-	c.authData.AuthorizeRequest(request)
+	authData.AuthorizeRequest(request)
 	return nil
 }
+
+func (a InternetAccess) Validate() error {
+	for _, possibleValue := range InternetAccessPossibleValues {
+		if string(a) == possibleValue {
+			return nil
+		}
+	}
+	possibleValues := strings.Join(InternetAccessPossibleValues, ", ")
+	return fmt.Errorf("invalid value for InternetAccess: '%s'. Possible values are: %s", a, possibleValues)
+}
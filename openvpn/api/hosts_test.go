@@ -23,6 +23,7 @@ func TestClient_GetHost(t *testing.T) {
 
 	t.Run("non-authenticated", func(t *testing.T) {
 		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
 		_, err := client.ListRegions(ctx)
 		assert.Error(t, err)
 		mockHttpClient.AssertExpectations(t)
@@ -71,6 +72,7 @@ func TestClient_CreateHost(t *testing.T) {
 
 	t.Run("non-authenticated", func(t *testing.T) {
 		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
 		_, err := client.CreateHost(ctx, createHostRequest)
 		assert.Error(t, err)
 		mockHttpClient.AssertExpectations(t)
@@ -113,6 +115,7 @@ func TestClient_UpdateHost(t *testing.T) {
 
 	t.Run("non-authenticated", func(t *testing.T) {
 		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
 		_, err := client.UpdateHost(ctx, hostID, updateHostRequest)
 		assert.Error(t, err)
 		mockHttpClient.AssertExpectations(t)
@@ -282,3 +285,10 @@ func TestClient_CreateGetDeleteHost_Real(t *testing.T) {
 	err = client.DeleteHost(ctx, host.ID)
 	assert.NoError(t, err)
 }
+
+func TestInternetAccess_Validate(t *testing.T) {
+	assert.NoError(t, InternetAccessBlocked.Validate())
+	assert.NoError(t, InternetAccessGlobalInternet.Validate())
+	assert.NoError(t, InternetAccessLocal.Validate())
+	assert.EqualError(t, InternetAccess("GLOBAL").Validate(), "invalid value for InternetAccess: 'GLOBAL'. Possible values are: BLOCKED, GLOBAL_INTERNET, LOCAL")
+}
@@ -0,0 +1,156 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+type IPServiceType string
+
+type ServiceProtocol string
+
+type IPService struct {
+	ID              string           `json:"id"`
+	Name            string           `json:"name"`
+	Description     string           `json:"description"`
+	NetworkItemId   string           `json:"networkItemId"`
+	NetworkItemType NetworkItemType  `json:"networkItemType"`
+	Type            IPServiceType    `json:"type"`
+	Routes          []IPServiceRoute `json:"routes"`
+	Config          *ServiceConfig   `json:"config"`
+}
+
+type IPServiceRoute struct {
+	Description string `json:"description,omitempty"`
+	Value       string `json:"value"`
+}
+
+// ServiceConfig restricts which protocols and ports of a service are reachable.
+type ServiceConfig struct {
+	ServiceTypes       []string            `json:"serviceTypes"`
+	CustomServiceTypes []CustomServiceType `json:"customServiceTypes"`
+}
+
+type CustomServiceType struct {
+	Protocol ServiceProtocol `json:"protocol"`
+	Port     []Range         `json:"port,omitempty"`
+	IcmpType []Range         `json:"icmpType,omitempty"`
+}
+
+type Range struct {
+	LowerValue int `json:"lowerValue"`
+	UpperValue int `json:"upperValue"`
+}
+
+type IPServiceData struct {
+	Name            string
+	Description     string
+	NetworkItemId   string
+	NetworkItemType NetworkItemType
+	Type            IPServiceType
+	Routes          []IPServiceRoute
+	Config          *ServiceConfig
+}
+
+type IPServiceRequest struct {
+	Name        string           `json:"name"`
+	Description string           `json:"description,omitempty"`
+	Type        IPServiceType    `json:"type"`
+	Routes      []IPServiceRoute `json:"routes"`
+	Config      *ServiceConfig   `json:"config,omitempty"`
+}
+
+const (
+	IPServicesEndpoint    = "/ip-services"
+	IPServiceByIdEndpoint = "/ip-services/%s"
+)
+
+const (
+	IPServiceTypeIPSource           IPServiceType = "IP_SOURCE"
+	IPServiceTypeServiceDestination IPServiceType = "SERVICE_DESTINATION"
+)
+
+const (
+	ServiceProtocolTCP  ServiceProtocol = "TCP"
+	ServiceProtocolUDP  ServiceProtocol = "UDP"
+	ServiceProtocolICMP ServiceProtocol = "ICMP"
+)
+
+var IPServiceTypePossibleValues = []string{string(IPServiceTypeIPSource), string(IPServiceTypeServiceDestination)}
+
+var ServiceProtocolPossibleValues = []string{string(ServiceProtocolTCP), string(ServiceProtocolUDP), string(ServiceProtocolICMP)}
+
+// ServiceTypePossibleValues are the predefined services that can be allowed without listing their ports.
+var ServiceTypePossibleValues = []string{
+	"ANY", "BGP", "CUSTOM", "DHCP", "DNS", "FTP", "HTTP", "HTTPS", "IMAP", "IMAPS", "NTP",
+	"POP3", "POP3S", "SMTP", "SMTPS", "SNMP", "SSH", "TELNET", "TFTP",
+}
+
+func (c *Client) GetIPService(ctx context.Context, id string) (*IPService, error) {
+	service := new(IPService)
+	err := c.newRequest(ctx, "GET", c.apiEndpoint(IPServiceByIdEndpoint, id), nil, service)
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func (c *Client) CreateIPService(ctx context.Context, request *IPServiceData) (*IPService, error) {
+	endpoint := c.networkItemUrl(c.apiEndpoint(IPServicesEndpoint), request.NetworkItemId, request.NetworkItemType)
+
+	service := new(IPService)
+	err := c.newRequestJSON(ctx, "POST", endpoint.String(), request.internalRequest(), service)
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func (c *Client) UpdateIPService(ctx context.Context, id string, request *IPServiceData) (*IPService, error) {
+	endpoint := c.networkItemUrl(c.apiEndpoint(IPServiceByIdEndpoint, id), request.NetworkItemId, request.NetworkItemType)
+
+	service := new(IPService)
+	err := c.newRequestJSON(ctx, "PUT", endpoint.String(), request.internalRequest(), service)
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func (c *Client) DeleteIPService(ctx context.Context, id string) error {
+	return c.newRequest(ctx, "DELETE", c.apiEndpoint(IPServiceByIdEndpoint, id), nil, nil)
+}
+
+func (r *IPServiceData) internalRequest() *IPServiceRequest {
+	return &IPServiceRequest{
+		Name:        r.Name,
+		Description: r.Description,
+		Type:        r.Type,
+		Routes:      r.Routes,
+		Config:      r.Config,
+	}
+}
+
+func (t IPServiceType) Validate() error {
+	for _, possibleValue := range IPServiceTypePossibleValues {
+		if string(t) == possibleValue {
+			return nil
+		}
+	}
+	possibleValues := strings.Join(IPServiceTypePossibleValues, ", ")
+	return fmt.Errorf("invalid value for IPServiceType: '%s'. Possible values are: %s", t, possibleValues)
+}
+
+func (p ServiceProtocol) Validate() error {
+	for _, possibleValue := range ServiceProtocolPossibleValues {
+		if string(p) == possibleValue {
+			return nil
+		}
+	}
+	possibleValues := strings.Join(ServiceProtocolPossibleValues, ", ")
+	return fmt.Errorf("invalid value for ServiceProtocol: '%s'. Possible values are: %s", p, possibleValues)
+}
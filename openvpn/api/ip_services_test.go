@@ -0,0 +1,176 @@
+package api
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetIPService(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	authConfig := getAuthConfigTestData()
+	ctx := context.Background()
+	authData := &AuthData{AccessToken: "AccessToken"}
+
+	t.Run("non-authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
+
+		// when
+		_, err := client.GetIPService(ctx, "123")
+
+		// then
+		assert.Error(t, err)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+
+		expectedService := &IPService{
+			ID:              "service-id-123",
+			Name:            "ssh",
+			NetworkItemId:   "host-id",
+			NetworkItemType: NetworkItemTypeHost,
+			Type:            IPServiceTypeServiceDestination,
+			Routes:          []IPServiceRoute{{Value: "10.0.0.0/24"}},
+			Config: &ServiceConfig{
+				ServiceTypes: []string{"SSH"},
+				CustomServiceTypes: []CustomServiceType{
+					{Protocol: ServiceProtocolTCP, Port: []Range{{LowerValue: 8000, UpperValue: 8080}}},
+				},
+			},
+		}
+
+		mockHttpClient.mockDo(t, expectedService, func(request *http.Request) {
+			assertRequestAuthorizedWithToken(t, request, authData.AccessToken)
+			assert.Equal(t, "GET", request.Method)
+			assert.True(t, strings.HasSuffix(request.URL.Path, IPServicesEndpoint+"/"+expectedService.ID))
+		})
+
+		// when
+		service, err := client.GetIPService(ctx, expectedService.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, expectedService, service)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestClient_CreateIPService(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	authConfig := getAuthConfigTestData()
+	ctx := context.Background()
+	authData := &AuthData{AccessToken: "AccessToken"}
+
+	request := &IPServiceData{
+		Name:            "web",
+		NetworkItemId:   "host-id",
+		NetworkItemType: NetworkItemTypeHost,
+		Type:            IPServiceTypeServiceDestination,
+		Routes:          []IPServiceRoute{{Value: "10.0.0.1/32"}},
+		Config: &ServiceConfig{
+			ServiceTypes: []string{"HTTPS"},
+		},
+	}
+
+	t.Run("non-authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
+
+		// when
+		service, err := client.CreateIPService(ctx, request)
+
+		// then
+		assert.Error(t, err)
+		assert.Nil(t, service)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+
+		response := &IPService{ID: "service-id", Name: request.Name}
+
+		mockHttpClient.mockDo(t, response, func(httpRequest *http.Request) {
+			assertRequestAuthorizedWithToken(t, httpRequest, authData.AccessToken)
+			assert.Equal(t, "POST", httpRequest.Method)
+			assert.True(t, strings.HasSuffix(httpRequest.URL.Path, IPServicesEndpoint))
+			assert.Equal(t, request.NetworkItemId, httpRequest.URL.Query().Get("networkItemId"))
+			assert.Equal(t, string(request.NetworkItemType), httpRequest.URL.Query().Get("networkItemType"))
+
+			body := &IPServiceRequest{}
+			decodeRequestBody(t, httpRequest, body)
+			assert.Equal(t, request.internalRequest(), body)
+		})
+
+		// when
+		service, err := client.CreateIPService(ctx, request)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, response, service)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestClient_UpdateIPService(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	request := &IPServiceData{
+		Name:            "web",
+		NetworkItemId:   "network-id",
+		NetworkItemType: NetworkItemTypeNetwork,
+		Type:            IPServiceTypeIPSource,
+		Routes:          []IPServiceRoute{{Value: "10.0.0.0/16"}},
+	}
+	response := &IPService{ID: "service-id", Name: request.Name}
+
+	mockHttpClient.mockDo(t, response, func(httpRequest *http.Request) {
+		assert.Equal(t, "PUT", httpRequest.Method)
+		assert.True(t, strings.HasSuffix(httpRequest.URL.Path, response.ID))
+		assert.Equal(t, request.NetworkItemId, httpRequest.URL.Query().Get("networkItemId"))
+		assert.Equal(t, string(request.NetworkItemType), httpRequest.URL.Query().Get("networkItemType"))
+	})
+
+	service, err := client.UpdateIPService(context.Background(), response.ID, request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, response, service)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestClient_DeleteIPService(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	mockHttpClient.mockDo(t, nil, func(request *http.Request) {
+		assert.Equal(t, "DELETE", request.Method)
+		assert.True(t, strings.HasSuffix(request.URL.Path, "service-id"))
+	})
+
+	err := client.DeleteIPService(context.Background(), "service-id")
+
+	assert.NoError(t, err)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestServiceProtocol_Validate(t *testing.T) {
+	assert.NoError(t, ServiceProtocolICMP.Validate())
+	assert.EqualError(t, ServiceProtocol("SCTP").Validate(),
+		"invalid value for ServiceProtocol: 'SCTP'. Possible values are: TCP, UDP, ICMP")
+	assert.EqualError(t, IPServiceType("DESTINATION").Validate(),
+		"invalid value for IPServiceType: 'DESTINATION'. Possible values are: IP_SOURCE, SERVICE_DESTINATION")
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"context"
+)
+
+type LocationContext struct {
+	ID            string         `json:"id,omitempty"`
+	Name          string         `json:"name"`
+	Description   string         `json:"description"`
+	UserGroupsIds []string       `json:"userGroupsIds"`
+	IpPolicy      *IpPolicy      `json:"ipPolicy,omitempty"`
+	CountryPolicy *CountryPolicy `json:"countryPolicy,omitempty"`
+	DefaultPolicy *DefaultPolicy `json:"defaultPolicy"`
+}
+
+type IpPolicy struct {
+	Allowed bool         `json:"allowed"`
+	Ips     []IpPolicyIp `json:"ips"`
+}
+
+type IpPolicyIp struct {
+	Ip          string `json:"ip"`
+	Description string `json:"description,omitempty"`
+}
+
+// CountryPolicy matches users by the ISO 3166-1 alpha-2 code of the country they connect from.
+type CountryPolicy struct {
+	Allowed   bool     `json:"allowed"`
+	Countries []string `json:"countries"`
+}
+
+// DefaultPolicy applies when neither the ip nor the country policy matches.
+type DefaultPolicy struct {
+	Allowed bool `json:"allowed"`
+}
+
+const (
+	LocationContextsEndpoint    = "/location-contexts"
+	LocationContextByIdEndpoint = "/location-contexts/%s"
+)
+
+func (c *Client) GetLocationContext(ctx context.Context, id string) (*LocationContext, error) {
+	locationContext := new(LocationContext)
+
+	err := c.newRequest(ctx, "GET", c.apiEndpoint(LocationContextByIdEndpoint, id), nil, locationContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return locationContext, nil
+}
+
+func (c *Client) CreateLocationContext(ctx context.Context, request *LocationContext) (*LocationContext, error) {
+	locationContext := new(LocationContext)
+
+	err := c.newRequestJSON(ctx, "POST", c.apiEndpoint(LocationContextsEndpoint), request, locationContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return locationContext, nil
+}
+
+func (c *Client) UpdateLocationContext(ctx context.Context, id string, request *LocationContext) (*LocationContext, error) {
+	locationContext := new(LocationContext)
+
+	err := c.newRequestJSON(ctx, "PUT", c.apiEndpoint(LocationContextByIdEndpoint, id), request, locationContext)
+	if err != nil {
+		return nil, err
+	}
+
+	return locationContext, nil
+}
+
+func (c *Client) DeleteLocationContext(ctx context.Context, id string) error {
+	return c.newRequest(ctx, "DELETE", c.apiEndpoint(LocationContextByIdEndpoint, id), nil, nil)
+}
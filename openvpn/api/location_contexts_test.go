@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetLocationContext(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	authConfig := getAuthConfigTestData()
+	ctx := context.Background()
+	authData := &AuthData{AccessToken: "AccessToken"}
+
+	t.Run("non-authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
+
+		// when
+		_, err := client.GetLocationContext(ctx, "123")
+
+		// then
+		assert.Error(t, err)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+
+		expectedLocationContext := &LocationContext{
+			ID:            "location-context-id",
+			Name:          "office only",
+			UserGroupsIds: []string{"group-id"},
+			IpPolicy:      &IpPolicy{Allowed: true, Ips: []IpPolicyIp{{Ip: "192.0.2.0/24", Description: "office"}}},
+			CountryPolicy: &CountryPolicy{Allowed: true, Countries: []string{"DE", "US"}},
+			DefaultPolicy: &DefaultPolicy{Allowed: false},
+		}
+
+		mockHttpClient.mockDo(t, expectedLocationContext, func(request *http.Request) {
+			assertRequestAuthorizedWithToken(t, request, authData.AccessToken)
+			assert.Equal(t, "GET", request.Method)
+			assert.True(t, strings.HasSuffix(request.URL.Path, LocationContextsEndpoint+"/"+expectedLocationContext.ID))
+		})
+
+		// when
+		locationContext, err := client.GetLocationContext(ctx, expectedLocationContext.ID)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, expectedLocationContext, locationContext)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestClient_CreateLocationContext(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	request := &LocationContext{
+		Name:          "eu only",
+		UserGroupsIds: []string{"group-id"},
+		CountryPolicy: &CountryPolicy{Allowed: true, Countries: []string{"DE"}},
+		DefaultPolicy: &DefaultPolicy{Allowed: false},
+	}
+	response := &LocationContext{ID: "location-context-id", Name: request.Name}
+
+	mockHttpClient.mockDo(t, response, func(httpRequest *http.Request) {
+		assert.Equal(t, "POST", httpRequest.Method)
+		assert.True(t, strings.HasSuffix(httpRequest.URL.Path, LocationContextsEndpoint))
+
+		body := &LocationContext{}
+		decodeRequestBody(t, httpRequest, body)
+		assert.Equal(t, request, body)
+	})
+
+	locationContext, err := client.CreateLocationContext(context.Background(), request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, response, locationContext)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestClient_UpdateLocationContext(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	request := &LocationContext{Name: "renamed", DefaultPolicy: &DefaultPolicy{Allowed: true}}
+	response := &LocationContext{ID: "location-context-id", Name: request.Name}
+
+	mockHttpClient.mockDo(t, response, func(httpRequest *http.Request) {
+		assert.Equal(t, "PUT", httpRequest.Method)
+		assert.True(t, strings.HasSuffix(httpRequest.URL.Path, response.ID))
+	})
+
+	locationContext, err := client.UpdateLocationContext(context.Background(), response.ID, request)
+
+	assert.NoError(t, err)
+	assert.Equal(t, response, locationContext)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestClient_DeleteLocationContext(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	mockHttpClient.mockDo(t, nil, func(request *http.Request) {
+		assert.Equal(t, "DELETE", request.Method)
+		assert.True(t, strings.HasSuffix(request.URL.Path, "location-context-id"))
+	})
+
+	err := client.DeleteLocationContext(context.Background(), "location-context-id")
+
+	assert.NoError(t, err)
+	mockHttpClient.AssertExpectations(t)
+}
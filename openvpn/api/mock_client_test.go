@@ -3,10 +3,12 @@ package api
 import (
 	"bytes"
 	"encoding/json"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -40,6 +42,30 @@ func (m *mockHttpClient) mockDo(t *testing.T, responseBody interface{}, requestT
 	return call
 }
 
+func (m *mockHttpClient) mockDoStatus(t *testing.T, statusCode int, responseBody interface{}, requestTestFunction func(request *http.Request)) *mock.Call {
+	response := createTestResponse(t, responseBody)
+	response.Code = statusCode
+
+	call := m.
+		On("Do", mock.AnythingOfType("*http.Request")).
+		Return(response.Result(), nil)
+
+	if requestTestFunction != nil {
+		call = call.Run(func(args mock.Arguments) {
+			request := getRequestFromArgs(t, args)
+			requestTestFunction(request)
+		})
+	}
+
+	return call
+}
+
+func (m *mockHttpClient) mockFailedAuthentication(t *testing.T) *mock.Call {
+	return m.mockDoStatus(t, http.StatusUnauthorized, nil, func(request *http.Request) {
+		assert.True(t, strings.HasSuffix(request.URL.Path, TokenEndpoint))
+	}).Once()
+}
+
 func (m *mockHttpClient) mockDoBytes(t *testing.T, responseBody []byte, requestTestFunction func(request *http.Request)) *mock.Call {
 	response := httptest.NewRecorder()
 	response.Body = bytes.NewBuffer(responseBody)
@@ -67,3 +93,9 @@ func createTestResponse(t *testing.T, mockResponseBody interface{}) *httptest.Re
 	}
 	return response
 }
+
+func decodeRequestBody(t *testing.T, request *http.Request, body interface{}) {
+	require.NotNil(t, request.Body, "missing request body")
+	err := json.NewDecoder(request.Body).Decode(body)
+	require.NoError(t, err)
+}
@@ -0,0 +1,147 @@
+package api
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type ConnectorProfile struct {
+	Remotes     []ProfileRemote
+	Protocol    string
+	Cipher      string
+	DataCiphers []string
+	Auth        string
+	CA          string
+	Cert        string
+	Key         string
+	TLSCrypt    string
+}
+
+type ProfileRemote struct {
+	Host     string
+	Port     int
+	Protocol string
+}
+
+const (
+	defaultProfilePort     = 1194
+	defaultProfileProtocol = "udp"
+)
+
+// ParseConnectorProfile extracts the remotes, inline certificates and keys and the cipher
+// directives from an OpenVPN (.ovpn) profile.
+func ParseConnectorProfile(profile string) (*ConnectorProfile, error) {
+	parsed := &ConnectorProfile{}
+	inlineBlocks := map[string]*string{
+		"ca":        &parsed.CA,
+		"cert":      &parsed.Cert,
+		"key":       &parsed.Key,
+		"tls-crypt": &parsed.TLSCrypt,
+	}
+
+	defaultPort := defaultProfilePort
+	openBlock := ""
+	var blockLines []string
+
+	scanner := bufio.NewScanner(strings.NewReader(profile))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if openBlock != "" {
+			if line == "</"+openBlock+">" {
+				if target, ok := inlineBlocks[openBlock]; ok {
+					*target = strings.Join(blockLines, "\n") + "\n"
+				}
+				openBlock = ""
+				blockLines = nil
+				continue
+			}
+			blockLines = append(blockLines, line)
+			continue
+		}
+
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "<") && strings.HasSuffix(line, ">") && !strings.HasPrefix(line, "</") {
+			openBlock = line[1 : len(line)-1]
+			continue
+		}
+
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "remote":
+			remote, err := parseProfileRemote(fields[1:])
+			if err != nil {
+				return nil, err
+			}
+			parsed.Remotes = append(parsed.Remotes, remote)
+		case "port":
+			if len(fields) > 1 {
+				port, err := strconv.Atoi(fields[1])
+				if err != nil {
+					return nil, fmt.Errorf("invalid port directive '%s'", line)
+				}
+				defaultPort = port
+			}
+		case "proto":
+			if len(fields) > 1 {
+				parsed.Protocol = fields[1]
+			}
+		case "cipher":
+			if len(fields) > 1 {
+				parsed.Cipher = fields[1]
+			}
+		case "data-ciphers":
+			if len(fields) > 1 {
+				parsed.DataCiphers = strings.Split(fields[1], ":")
+			}
+		case "auth":
+			if len(fields) > 1 {
+				parsed.Auth = fields[1]
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if openBlock != "" {
+		return nil, fmt.Errorf("unterminated <%s> block in profile", openBlock)
+	}
+
+	for i := range parsed.Remotes {
+		if parsed.Remotes[i].Port == 0 {
+			parsed.Remotes[i].Port = defaultPort
+		}
+		if parsed.Remotes[i].Protocol == "" {
+			parsed.Remotes[i].Protocol = parsed.Protocol
+		}
+		if parsed.Remotes[i].Protocol == "" {
+			parsed.Remotes[i].Protocol = defaultProfileProtocol
+		}
+	}
+
+	return parsed, nil
+}
+
+func parseProfileRemote(args []string) (ProfileRemote, error) {
+	if len(args) == 0 {
+		return ProfileRemote{}, fmt.Errorf("remote directive without host")
+	}
+
+	remote := ProfileRemote{Host: args[0]}
+	if len(args) > 1 {
+		port, err := strconv.Atoi(args[1])
+		if err != nil {
+			return ProfileRemote{}, fmt.Errorf("invalid port in remote directive '%s'", strings.Join(args, " "))
+		}
+		remote.Port = port
+	}
+	if len(args) > 2 {
+		remote.Protocol = args[2]
+	}
+	return remote, nil
+}
@@ -2,8 +2,6 @@ package api
 
 import (
 	"context"
-	"errors"
-	"net/http"
 )
 
 type Region struct {
@@ -16,25 +14,19 @@ type Region struct {
 
 const RegionsEndpoint = "/regions"
 
-func (c Client) ListRegions(ctx context.Context) ([]Region, error) {
-	if !c.IsAuthenticated() {
-		return nil, errors.New("authentication is required")
-	}
-
-	request, err := http.NewRequest("GET", c.apiEndpoint(RegionsEndpoint), nil)
+// ListRegions returns the available VPN regions, the list is cached for DefaultCacheTTL.
+func (c *Client) ListRegions(ctx context.Context) ([]Region, error) {
+	cached, err := c.regionsCache.getOrLoad(ctx, RegionsEndpoint, func(ctx context.Context) (interface{}, error) {
+		var regions []Region
+		err := c.newRequest(ctx, "GET", c.apiEndpoint(RegionsEndpoint), nil, &regions)
+		return regions, err
+	})
 	if err != nil {
 		return nil, err
 	}
-	c.authData.AuthorizeRequest(request)
 
-	response, err := c.client.Do(request.WithContext(ctx))
-	if err != nil {
-		return nil, err
-	}
-	var regions []Region
-	err = processJsonResponse(response, &regions)
-	if err != nil {
-		return nil, err
-	}
+	cachedRegions := cached.([]Region)
+	regions := make([]Region, len(cachedRegions))
+	copy(regions, cachedRegions)
 	return regions, nil
 }
@@ -17,6 +17,7 @@ func TestClient_ListRegions(t *testing.T) {
 
 	t.Run("non-authenticated", func(t *testing.T) {
 		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
 		_, err := client.ListRegions(ctx)
 		assert.Error(t, err)
 		mockHttpClient.AssertExpectations(t)
@@ -41,6 +42,27 @@ func TestClient_ListRegions(t *testing.T) {
 		assert.Equal(t, expectedRegions, regions)
 		mockHttpClient.AssertExpectations(t)
 	})
+
+	t.Run("cached", func(t *testing.T) {
+		mockHttpClient := newMockHttpClient()
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+
+		expectedRegions := []Region{
+			{
+				ID: "us-west-2",
+			},
+		}
+		mockHttpClient.mockDo(t, expectedRegions, nil).Once()
+
+		_, err := client.ListRegions(ctx)
+		require.NoError(t, err)
+		regions, err := client.ListRegions(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expectedRegions, regions)
+		mockHttpClient.AssertNumberOfCalls(t, "Do", 1)
+	})
 }
 
 func TestClient_ListRegions_Real(t *testing.T) {
@@ -0,0 +1,173 @@
+package api
+
+import (
+	"context"
+)
+
+type DnsServers struct {
+	PrimaryIpV4   string `json:"primaryIpV4,omitempty"`
+	SecondaryIpV4 string `json:"secondaryIpV4,omitempty"`
+}
+
+type DefaultRegion struct {
+	VpnRegionId string `json:"vpnRegionId"`
+}
+
+type AutoConnect struct {
+	Enabled bool `json:"enabled"`
+}
+
+// DomainRoutingSubnet is the range addresses are assigned from to route traffic of applications by domain.
+type DomainRoutingSubnet struct {
+	IpV4Address string `json:"ipV4Address"`
+	IpV6Address string `json:"ipV6Address"`
+}
+
+// IdentityProvider is the SAML identity provider users sign in with. The metadata of the provider is either
+// fetched from MetadataUrl or given as MetadataXml.
+type IdentityProvider struct {
+	Enabled               bool                               `json:"enabled"`
+	MetadataUrl           string                             `json:"metadataUrl,omitempty"`
+	MetadataXml           string                             `json:"metadataXml,omitempty"`
+	SpEntityId            string                             `json:"spEntityId,omitempty"`
+	IdpEntityId           string                             `json:"idpEntityId,omitempty"`
+	AttributeMappings     *IdentityProviderAttributeMappings `json:"attributeMappings,omitempty"`
+	GroupAutoProvisioning bool                               `json:"groupAutoProvisioning"`
+}
+
+// IdentityProviderAttributeMappings are the names of the SAML attributes the user details are read from.
+type IdentityProviderAttributeMappings struct {
+	Username  string `json:"username,omitempty"`
+	Email     string `json:"email,omitempty"`
+	FirstName string `json:"firstName,omitempty"`
+	LastName  string `json:"lastName,omitempty"`
+	Groups    string `json:"groups,omitempty"`
+}
+
+const (
+	DnsServersSettingsEndpoint          = "/settings/dns-servers"
+	DefaultRegionSettingsEndpoint       = "/settings/default-region"
+	AutoConnectSettingsEndpoint         = "/settings/auto-connect"
+	DomainRoutingSubnetSettingsEndpoint = "/settings/domain-routing-subnet"
+	IdentityProviderSettingsEndpoint    = "/settings/identity-provider"
+)
+
+func (c *Client) GetDnsServers(ctx context.Context) (*DnsServers, error) {
+	dnsServers := new(DnsServers)
+	err := c.newRequest(ctx, "GET", c.apiEndpoint(DnsServersSettingsEndpoint), nil, dnsServers)
+	if err != nil {
+		return nil, err
+	}
+
+	return dnsServers, nil
+}
+
+func (c *Client) UpdateDnsServers(ctx context.Context, request *DnsServers) (*DnsServers, error) {
+	dnsServers := new(DnsServers)
+	err := c.newRequestJSON(ctx, "PUT", c.apiEndpoint(DnsServersSettingsEndpoint), request, dnsServers)
+	if err != nil {
+		return nil, err
+	}
+
+	return dnsServers, nil
+}
+
+// ResetDnsServers removes the custom DNS servers so the default ones are used again.
+func (c *Client) ResetDnsServers(ctx context.Context) error {
+	return c.newRequest(ctx, "DELETE", c.apiEndpoint(DnsServersSettingsEndpoint), nil, nil)
+}
+
+func (c *Client) GetDefaultRegion(ctx context.Context) (*DefaultRegion, error) {
+	defaultRegion := new(DefaultRegion)
+	err := c.newRequest(ctx, "GET", c.apiEndpoint(DefaultRegionSettingsEndpoint), nil, defaultRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	return defaultRegion, nil
+}
+
+func (c *Client) UpdateDefaultRegion(ctx context.Context, request *DefaultRegion) (*DefaultRegion, error) {
+	defaultRegion := new(DefaultRegion)
+	err := c.newRequestJSON(ctx, "PUT", c.apiEndpoint(DefaultRegionSettingsEndpoint), request, defaultRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	return defaultRegion, nil
+}
+
+func (c *Client) GetAutoConnect(ctx context.Context) (*AutoConnect, error) {
+	autoConnect := new(AutoConnect)
+	err := c.newRequest(ctx, "GET", c.apiEndpoint(AutoConnectSettingsEndpoint), nil, autoConnect)
+	if err != nil {
+		return nil, err
+	}
+
+	return autoConnect, nil
+}
+
+func (c *Client) UpdateAutoConnect(ctx context.Context, request *AutoConnect) (*AutoConnect, error) {
+	autoConnect := new(AutoConnect)
+	err := c.newRequestJSON(ctx, "PUT", c.apiEndpoint(AutoConnectSettingsEndpoint), request, autoConnect)
+	if err != nil {
+		return nil, err
+	}
+
+	return autoConnect, nil
+}
+
+// ResetAutoConnect restores the default auto-connect setting.
+func (c *Client) ResetAutoConnect(ctx context.Context) error {
+	return c.newRequest(ctx, "DELETE", c.apiEndpoint(AutoConnectSettingsEndpoint), nil, nil)
+}
+
+func (c *Client) GetDomainRoutingSubnet(ctx context.Context) (*DomainRoutingSubnet, error) {
+	subnet := new(DomainRoutingSubnet)
+	err := c.newRequest(ctx, "GET", c.apiEndpoint(DomainRoutingSubnetSettingsEndpoint), nil, subnet)
+	if err != nil {
+		return nil, err
+	}
+
+	return subnet, nil
+}
+
+func (c *Client) UpdateDomainRoutingSubnet(ctx context.Context, request *DomainRoutingSubnet) (*DomainRoutingSubnet, error) {
+	subnet := new(DomainRoutingSubnet)
+	err := c.newRequestJSON(ctx, "PUT", c.apiEndpoint(DomainRoutingSubnetSettingsEndpoint), request, subnet)
+	if err != nil {
+		return nil, err
+	}
+
+	return subnet, nil
+}
+
+// ResetDomainRoutingSubnet restores the default domain routing subnets.
+func (c *Client) ResetDomainRoutingSubnet(ctx context.Context) error {
+	return c.newRequest(ctx, "DELETE", c.apiEndpoint(DomainRoutingSubnetSettingsEndpoint), nil, nil)
+}
+
+func (c *Client) GetIdentityProvider(ctx context.Context) (*IdentityProvider, error) {
+	identityProvider := new(IdentityProvider)
+	err := c.newRequest(ctx, "GET", c.apiEndpoint(IdentityProviderSettingsEndpoint), nil, identityProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	return identityProvider, nil
+}
+
+func (c *Client) UpdateIdentityProvider(ctx context.Context, request *IdentityProvider) (*IdentityProvider, error) {
+	identityProvider := new(IdentityProvider)
+	err := c.newRequestJSON(ctx, "PUT", c.apiEndpoint(IdentityProviderSettingsEndpoint), request, identityProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	return identityProvider, nil
+}
+
+// ResetIdentityProvider removes the identity provider, users sign in with their OpenVPN credentials again.
+func (c *Client) ResetIdentityProvider(ctx context.Context) error {
+	return c.newRequest(ctx, "DELETE", c.apiEndpoint(IdentityProviderSettingsEndpoint), nil, nil)
+}
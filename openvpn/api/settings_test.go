@@ -0,0 +1,209 @@
+package api
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestClient_GetDnsServers(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	authConfig := getAuthConfigTestData()
+	ctx := context.Background()
+	authData := &AuthData{AccessToken: "AccessToken"}
+
+	t.Run("non-authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
+
+		// when
+		_, err := client.GetDnsServers(ctx)
+
+		// then
+		assert.Error(t, err)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+		expectedDnsServers := &DnsServers{PrimaryIpV4: "1.1.1.1", SecondaryIpV4: "8.8.8.8"}
+
+		mockHttpClient.mockDo(t, expectedDnsServers, func(request *http.Request) {
+			assertRequestAuthorizedWithToken(t, request, authData.AccessToken)
+			assert.Equal(t, "GET", request.Method)
+			assert.True(t, strings.HasSuffix(request.URL.Path, DnsServersSettingsEndpoint))
+		})
+
+		// when
+		dnsServers, err := client.GetDnsServers(ctx)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, expectedDnsServers, dnsServers)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestClient_UpdateSettings(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		endpoint string
+		request  interface{}
+		update   func(client *Client) (interface{}, error)
+	}{
+		"dns servers": {
+			endpoint: DnsServersSettingsEndpoint,
+			request:  &DnsServers{PrimaryIpV4: "1.1.1.1"},
+			update: func(client *Client) (interface{}, error) {
+				return client.UpdateDnsServers(ctx, &DnsServers{PrimaryIpV4: "1.1.1.1"})
+			},
+		},
+		"default region": {
+			endpoint: DefaultRegionSettingsEndpoint,
+			request:  &DefaultRegion{VpnRegionId: "eu-central-1"},
+			update: func(client *Client) (interface{}, error) {
+				return client.UpdateDefaultRegion(ctx, &DefaultRegion{VpnRegionId: "eu-central-1"})
+			},
+		},
+		"auto connect": {
+			endpoint: AutoConnectSettingsEndpoint,
+			request:  &AutoConnect{Enabled: true},
+			update: func(client *Client) (interface{}, error) {
+				return client.UpdateAutoConnect(ctx, &AutoConnect{Enabled: true})
+			},
+		},
+		"domain routing subnet": {
+			endpoint: DomainRoutingSubnetSettingsEndpoint,
+			request:  &DomainRoutingSubnet{IpV4Address: "100.96.0.0/11", IpV6Address: "fd00:0:0:8000::/49"},
+			update: func(client *Client) (interface{}, error) {
+				return client.UpdateDomainRoutingSubnet(ctx, &DomainRoutingSubnet{IpV4Address: "100.96.0.0/11", IpV6Address: "fd00:0:0:8000::/49"})
+			},
+		},
+		"identity provider": {
+			endpoint: IdentityProviderSettingsEndpoint,
+			request:  identityProviderTestData(),
+			update: func(client *Client) (interface{}, error) {
+				return client.UpdateIdentityProvider(ctx, identityProviderTestData())
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			mockHttpClient := newMockHttpClient()
+			client := NewClient(mockHttpClient, getAuthConfigTestData())
+			client.authData = &AuthData{AccessToken: "AccessToken"}
+
+			mockHttpClient.mockDo(t, test.request, func(request *http.Request) {
+				assert.Equal(t, "PUT", request.Method)
+				assert.True(t, strings.HasSuffix(request.URL.Path, test.endpoint))
+			})
+
+			// when
+			settings, err := test.update(client)
+
+			// then
+			assert.NoError(t, err)
+			assert.Equal(t, test.request, settings)
+			mockHttpClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_ResetSettings(t *testing.T) {
+	ctx := context.Background()
+
+	tests := map[string]struct {
+		endpoint string
+		reset    func(client *Client) error
+	}{
+		"dns servers": {
+			endpoint: DnsServersSettingsEndpoint,
+			reset: func(client *Client) error {
+				return client.ResetDnsServers(ctx)
+			},
+		},
+		"auto connect": {
+			endpoint: AutoConnectSettingsEndpoint,
+			reset: func(client *Client) error {
+				return client.ResetAutoConnect(ctx)
+			},
+		},
+		"domain routing subnet": {
+			endpoint: DomainRoutingSubnetSettingsEndpoint,
+			reset: func(client *Client) error {
+				return client.ResetDomainRoutingSubnet(ctx)
+			},
+		},
+		"identity provider": {
+			endpoint: IdentityProviderSettingsEndpoint,
+			reset: func(client *Client) error {
+				return client.ResetIdentityProvider(ctx)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			mockHttpClient := newMockHttpClient()
+			client := NewClient(mockHttpClient, getAuthConfigTestData())
+			client.authData = &AuthData{AccessToken: "AccessToken"}
+
+			mockHttpClient.mockDo(t, nil, func(request *http.Request) {
+				assert.Equal(t, "DELETE", request.Method)
+				assert.True(t, strings.HasSuffix(request.URL.Path, test.endpoint))
+			})
+
+			// when
+			err := test.reset(client)
+
+			// then
+			assert.NoError(t, err)
+			mockHttpClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_GetIdentityProvider(t *testing.T) {
+	// given
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+	expectedIdentityProvider := identityProviderTestData()
+
+	mockHttpClient.mockDo(t, expectedIdentityProvider, func(request *http.Request) {
+		assert.Equal(t, "GET", request.Method)
+		assert.True(t, strings.HasSuffix(request.URL.Path, IdentityProviderSettingsEndpoint))
+	})
+
+	// when
+	identityProvider, err := client.GetIdentityProvider(context.Background())
+
+	// then
+	assert.NoError(t, err)
+	assert.Equal(t, expectedIdentityProvider, identityProvider)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func identityProviderTestData() *IdentityProvider {
+	return &IdentityProvider{
+		Enabled:     true,
+		MetadataUrl: "https://idp.example.com/saml/metadata",
+		SpEntityId:  "https://example.openvpn.com/saml",
+		IdpEntityId: "https://idp.example.com",
+		AttributeMappings: &IdentityProviderAttributeMappings{
+			Username: "uid",
+			Email:    "mail",
+			Groups:   "memberOf",
+		},
+		GroupAutoProvisioning: true,
+	}
+}
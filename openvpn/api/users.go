@@ -0,0 +1,208 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+type User struct {
+	ID        string   `json:"id"`
+	Username  string   `json:"username"`
+	Email     string   `json:"email,omitempty"`
+	FirstName string   `json:"firstName,omitempty"`
+	LastName  string   `json:"lastName,omitempty"`
+	Role      string   `json:"role,omitempty"`
+	Status    string   `json:"status,omitempty"`
+	GroupIds  []string `json:"groupIds"`
+}
+
+// UpdateUserRequest replaces every attribute of a user, attributes left empty are cleared.
+type UpdateUserRequest struct {
+	Username  string   `json:"username"`
+	Email     string   `json:"email"`
+	FirstName string   `json:"firstName"`
+	LastName  string   `json:"lastName"`
+	Role      string   `json:"role"`
+	Status    string   `json:"status"`
+	GroupIds  []string `json:"groupIds"`
+}
+
+type UsersPage struct {
+	Content       []User `json:"content"`
+	Page          int    `json:"page"`
+	Size          int    `json:"size"`
+	TotalElements int    `json:"totalElements"`
+	TotalPages    int    `json:"totalPages"`
+}
+
+const (
+	UsersEndpoint       = "/users"
+	UserByIdEndpoint    = "/users/%s"
+	UserProfileEndpoint = "/users/%s/profile"
+	UsersPageSize       = 100
+)
+
+// userLocks serializes read-modify-write updates of a single user, so concurrent
+// membership changes of different groups do not overwrite each other.
+type userLocks struct {
+	mutex sync.Mutex
+	locks map[string]*sync.Mutex
+}
+
+func (l *userLocks) lock(userId string) func() {
+	l.mutex.Lock()
+	if l.locks == nil {
+		l.locks = map[string]*sync.Mutex{}
+	}
+	userLock, ok := l.locks[userId]
+	if !ok {
+		userLock = &sync.Mutex{}
+		l.locks[userId] = userLock
+	}
+	l.mutex.Unlock()
+
+	userLock.Lock()
+	return userLock.Unlock
+}
+
+// ListUsers returns all users, fetching every page.
+func (c *Client) ListUsers(ctx context.Context) ([]User, error) {
+	var users []User
+	for page := 0; ; page++ {
+		endpoint, _ := url.Parse(c.apiEndpoint(UsersEndpoint))
+		query := endpoint.Query()
+		query.Set("page", strconv.Itoa(page))
+		query.Set("size", strconv.Itoa(UsersPageSize))
+		endpoint.RawQuery = query.Encode()
+
+		usersPage := new(UsersPage)
+		err := c.newRequest(ctx, "GET", endpoint.String(), nil, usersPage)
+		if err != nil {
+			return nil, err
+		}
+
+		users = append(users, usersPage.Content...)
+		if page+1 >= usersPage.TotalPages || len(usersPage.Content) == 0 {
+			return users, nil
+		}
+	}
+}
+
+func (c *Client) GetUser(ctx context.Context, userId string) (*User, error) {
+	user := new(User)
+	err := c.newRequest(ctx, "GET", c.apiEndpoint(UserByIdEndpoint, userId), nil, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (c *Client) UpdateUser(ctx context.Context, userId string, request *UpdateUserRequest) (*User, error) {
+	user := new(User)
+	err := c.newRequestJSON(ctx, "PUT", c.apiEndpoint(UserByIdEndpoint, userId), request, user)
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// AddUserToGroup adds the group to the groups of the user, it does nothing when the user is already a member.
+func (c *Client) AddUserToGroup(ctx context.Context, userId, groupId string) error {
+	return c.updateUserGroups(ctx, userId, func(groupIds []string) []string {
+		for _, id := range groupIds {
+			if id == groupId {
+				return nil
+			}
+		}
+		return append(groupIds, groupId)
+	})
+}
+
+// RemoveUserFromGroup removes the group from the groups of the user, it does nothing when the user is not a member.
+func (c *Client) RemoveUserFromGroup(ctx context.Context, userId, groupId string) error {
+	return c.updateUserGroups(ctx, userId, func(groupIds []string) []string {
+		remaining := make([]string, 0, len(groupIds))
+		for _, id := range groupIds {
+			if id != groupId {
+				remaining = append(remaining, id)
+			}
+		}
+		if len(remaining) == len(groupIds) {
+			return nil
+		}
+		return remaining
+	})
+}
+
+// updateUserGroups replaces the groups of the user with the result of update, a nil result leaves the user unchanged.
+// The API has no endpoint for group memberships, so the user is read and written back with every attribute it has.
+// Updates are serialized per user within the client only, a change of the user made elsewhere between the read and
+// the write is overwritten.
+func (c *Client) updateUserGroups(ctx context.Context, userId string, update func(groupIds []string) []string) error {
+	unlock := c.userLocks.lock(userId)
+	defer unlock()
+
+	user, err := c.GetUser(ctx, userId)
+	if err != nil {
+		return err
+	}
+
+	groupIds := update(user.GroupIds)
+	if groupIds == nil {
+		return nil
+	}
+
+	_, err = c.UpdateUser(ctx, userId, &UpdateUserRequest{
+		Username:  user.Username,
+		Email:     user.Email,
+		FirstName: user.FirstName,
+		LastName:  user.LastName,
+		Role:      user.Role,
+		Status:    user.Status,
+		GroupIds:  groupIds,
+	})
+	return err
+}
+
+// GetUserProfile returns a profile of the user for the device and region, an empty device or region lets the
+// API choose them. Profiles are cached per user, device and region for DefaultCacheTTL.
+func (c *Client) GetUserProfile(ctx context.Context, userId, deviceId, regionId string) (string, error) {
+	key := strings.Join([]string{"user", userId, deviceId, regionId}, "/")
+	profile, err := c.profilesCache.getOrLoad(ctx, key, func(ctx context.Context) (interface{}, error) {
+		return c.generateUserProfile(ctx, userId, deviceId, regionId)
+	})
+	if err != nil {
+		return "", err
+	}
+	return profile.(string), nil
+}
+
+func (c *Client) generateUserProfile(ctx context.Context, userId, deviceId, regionId string) (string, error) {
+	endpoint, _ := url.Parse(c.apiEndpoint(UserProfileEndpoint, userId))
+	query := endpoint.Query()
+	if deviceId != "" {
+		query.Set("deviceId", deviceId)
+	}
+	if regionId != "" {
+		query.Set("regionId", regionId)
+	}
+	endpoint.RawQuery = query.Encode()
+
+	response, err := c.newRequestWithResponse(ctx, "POST", endpoint.String(), bytes.NewBufferString(""))
+	if err != nil {
+		return "", err
+	}
+
+	data, err := c.getBytesResponse(response)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,241 @@
+package api
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestClient_ListUsers(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	authConfig := getAuthConfigTestData()
+	ctx := context.Background()
+	authData := &AuthData{AccessToken: "AccessToken"}
+
+	t.Run("non-authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
+
+		// when
+		_, err := client.ListUsers(ctx)
+
+		// then
+		assert.Error(t, err)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("all pages", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+
+		mockHttpClient.mockDo(t, &UsersPage{Content: []User{{ID: "1", Username: "alice"}}, TotalPages: 2}, func(request *http.Request) {
+			assertRequestAuthorizedWithToken(t, request, authData.AccessToken)
+			assert.True(t, strings.HasSuffix(request.URL.Path, UsersEndpoint))
+			assert.Equal(t, "0", request.URL.Query().Get("page"))
+			assert.Equal(t, "100", request.URL.Query().Get("size"))
+		}).Once()
+		mockHttpClient.mockDo(t, &UsersPage{Content: []User{{ID: "2", Username: "bob"}}, Page: 1, TotalPages: 2}, func(request *http.Request) {
+			assert.Equal(t, "1", request.URL.Query().Get("page"))
+		}).Once()
+
+		// when
+		users, err := client.ListUsers(ctx)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, []User{{ID: "1", Username: "alice"}, {ID: "2", Username: "bob"}}, users)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestClient_AddUserToGroup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("adds the group", func(t *testing.T) {
+		// given
+		mockHttpClient := newMockHttpClient()
+		client := NewClient(mockHttpClient, getAuthConfigTestData())
+		client.authData = &AuthData{AccessToken: "AccessToken"}
+		user := &User{
+			ID:        "user-id",
+			Username:  "alice",
+			Email:     "alice@example.com",
+			FirstName: "Alice",
+			LastName:  "Liddell",
+			Role:      "MEMBER",
+			Status:    "SUSPENDED",
+			GroupIds:  []string{"group-1"},
+		}
+
+		mockHttpClient.mockDo(t, user, func(request *http.Request) {
+			assert.Equal(t, "GET", request.Method)
+			assert.True(t, strings.HasSuffix(request.URL.Path, UsersEndpoint+"/"+user.ID))
+		}).Once()
+		mockHttpClient.mockDo(t, user, func(request *http.Request) {
+			assert.Equal(t, "PUT", request.Method)
+			body := &UpdateUserRequest{}
+			decodeRequestBody(t, request, body)
+			assert.Equal(t, &UpdateUserRequest{
+				Username:  user.Username,
+				Email:     user.Email,
+				FirstName: user.FirstName,
+				LastName:  user.LastName,
+				Role:      user.Role,
+				Status:    user.Status,
+				GroupIds:  []string{"group-1", "group-2"},
+			}, body)
+		}).Once()
+
+		// when
+		err := client.AddUserToGroup(ctx, user.ID, "group-2")
+
+		// then
+		assert.NoError(t, err)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("already a member", func(t *testing.T) {
+		// given
+		mockHttpClient := newMockHttpClient()
+		client := NewClient(mockHttpClient, getAuthConfigTestData())
+		client.authData = &AuthData{AccessToken: "AccessToken"}
+
+		mockHttpClient.mockDo(t, &User{ID: "user-id", GroupIds: []string{"group-1"}}, nil).Once()
+
+		// when
+		err := client.AddUserToGroup(ctx, "user-id", "group-1")
+
+		// then
+		assert.NoError(t, err)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestClient_RemoveUserFromGroup(t *testing.T) {
+	// given
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+
+	mockHttpClient.mockDo(t, &User{ID: "user-id", GroupIds: []string{"group-1", "group-2"}}, nil).Once()
+	mockHttpClient.mockDo(t, &User{ID: "user-id"}, func(request *http.Request) {
+		assert.Equal(t, "PUT", request.Method)
+		body := &UpdateUserRequest{}
+		decodeRequestBody(t, request, body)
+		assert.Equal(t, []string{"group-2"}, body.GroupIds)
+	}).Once()
+
+	// when
+	err := client.RemoveUserFromGroup(context.Background(), "user-id", "group-1")
+
+	// then
+	assert.NoError(t, err)
+	mockHttpClient.AssertExpectations(t)
+}
+
+func TestUserLocks(t *testing.T) {
+	locks := userLocks{}
+	var wg sync.WaitGroup
+	active := 0
+	maxActive := 0
+	var mutex sync.Mutex
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			unlock := locks.lock("user-id")
+			defer unlock()
+
+			mutex.Lock()
+			active++
+			if active > maxActive {
+				maxActive = active
+			}
+			mutex.Unlock()
+
+			mutex.Lock()
+			active--
+			mutex.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1, maxActive)
+}
+
+func TestClient_GetUserProfile(t *testing.T) {
+	mockHttpClient := newMockHttpClient()
+	authConfig := getAuthConfigTestData()
+	ctx := context.Background()
+	authData := &AuthData{AccessToken: "AccessToken"}
+
+	t.Run("non-authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		mockHttpClient.mockFailedAuthentication(t)
+
+		// when
+		_, err := client.GetUserProfile(ctx, "user-id", "", "")
+
+		// then
+		assert.Error(t, err)
+		mockHttpClient.AssertExpectations(t)
+	})
+
+	t.Run("authenticated", func(t *testing.T) {
+		// given
+		client := NewClient(mockHttpClient, authConfig)
+		client.authData = authData
+
+		exampleUserProfile := "Profile text\nMultiline"
+		mockHttpClient.mockDoBytes(t, []byte(exampleUserProfile), func(request *http.Request) {
+			assertRequestAuthorizedWithToken(t, request, authData.AccessToken)
+			assert.Equal(t, "POST", request.Method)
+			assert.True(t, strings.HasSuffix(request.URL.Path, "/users/user-id/profile"))
+			assert.Equal(t, "device-id", request.URL.Query().Get("deviceId"))
+			assert.Equal(t, "eu-central-1", request.URL.Query().Get("regionId"))
+		}).Once()
+
+		// when
+		userProfile, err := client.GetUserProfile(ctx, "user-id", "device-id", "eu-central-1")
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, exampleUserProfile, userProfile)
+		mockHttpClient.AssertExpectations(t)
+	})
+}
+
+func TestClient_GetUserProfile_cached(t *testing.T) {
+	// given
+	mockHttpClient := newMockHttpClient()
+	client := NewClient(mockHttpClient, getAuthConfigTestData())
+	client.authData = &AuthData{AccessToken: "AccessToken"}
+	ctx := context.Background()
+
+	mockHttpClient.mockDoBytes(t, []byte("default region profile"), func(request *http.Request) {
+		assert.Empty(t, request.URL.RawQuery)
+	}).Once()
+	mockHttpClient.mockDoBytes(t, []byte("us-east-1 profile"), nil).Once()
+
+	// when
+	first, err := client.GetUserProfile(ctx, "user-id", "", "")
+	require.NoError(t, err)
+	cached, err := client.GetUserProfile(ctx, "user-id", "", "")
+	require.NoError(t, err)
+	otherRegion, err := client.GetUserProfile(ctx, "user-id", "", "us-east-1")
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, "default region profile", first)
+	assert.Equal(t, "default region profile", cached)
+	assert.Equal(t, "us-east-1 profile", otherRegion)
+	mockHttpClient.AssertNumberOfCalls(t, "Do", 2)
+}
@@ -83,3 +83,35 @@ func getDefaultRegionID(t *testing.T, client *api.Client) string {
 	regionId := regions[0].ID
 	return regionId
 }
+
+func getTestUserGroupID(t *testing.T) string {
+	groupID := os.Getenv("OVPN_TEST_USER_GROUP_ID")
+	if groupID == "" {
+		t.Skip("OVPN_TEST_USER_GROUP_ID must be set to the id of a user group for this test")
+	}
+	return groupID
+}
+
+func getTestUsername(t *testing.T) string {
+	username := os.Getenv("OVPN_TEST_USERNAME")
+	if username == "" {
+		t.Skip("OVPN_TEST_USERNAME must be set to the username of an existing user for this test")
+	}
+	return username
+}
+
+func getTestUserID(t *testing.T) string {
+	userID := os.Getenv("OVPN_TEST_USER_ID")
+	if userID == "" {
+		t.Skip("OVPN_TEST_USER_ID must be set to the id of a user with an enrolled device for this test")
+	}
+	return userID
+}
+
+func getTestIdentityProviderMetadataURL(t *testing.T) string {
+	metadataURL := os.Getenv("OVPN_TEST_IDP_METADATA_URL")
+	if metadataURL == "" {
+		t.Skip("OVPN_TEST_IDP_METADATA_URL must be set to the SAML metadata url of an identity provider for this test")
+	}
+	return metadataURL
+}
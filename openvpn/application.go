@@ -0,0 +1,179 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+func resourceApplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceApplicationCreate,
+		ReadContext:   resourceApplicationRead,
+		UpdateContext: resourceApplicationUpdate,
+		DeleteContext: resourceApplicationDelete,
+		CustomizeDiff: customizeDiffServiceConfig,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"network_item_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"network_item_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+					value := api.NetworkItemType(i.(string))
+					return diag.FromErr(value.Validate())
+				},
+			},
+			"routes": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"domain": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validateApplicationDomain,
+						},
+						"allow_embedded_ip": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+			"config": serviceConfigSchema(),
+		},
+	}
+}
+
+func resourceApplicationCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	application, err := client.CreateApplication(ctx, makeApplicationRequest(data))
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setApplicationData(data, application)
+}
+
+func resourceApplicationRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	application, err := client.GetApplication(ctx, data.Id())
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setApplicationData(data, application)
+}
+
+func resourceApplicationUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	application, err := client.UpdateApplication(ctx, data.Id(), makeApplicationRequest(data))
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setApplicationData(data, application)
+}
+
+func resourceApplicationDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	err := client.DeleteApplication(ctx, data.Id())
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return nil
+}
+
+func makeApplicationRequest(data *schema.ResourceData) *api.ApplicationData {
+	routesI := data.Get("routes").([]interface{})
+	routes := make([]api.ApplicationRoute, len(routesI))
+	for i, routeI := range routesI {
+		routeData := routeI.(map[string]interface{})
+		routes[i] = api.ApplicationRoute{
+			Value:           routeData["domain"].(string),
+			AllowEmbeddedIp: routeData["allow_embedded_ip"].(bool),
+		}
+	}
+
+	return &api.ApplicationData{
+		Name:            data.Get("name").(string),
+		Description:     data.Get("description").(string),
+		NetworkItemId:   data.Get("network_item_id").(string),
+		NetworkItemType: api.NetworkItemType(data.Get("network_item_type").(string)),
+		Routes:          routes,
+		Config:          expandServiceConfig(data.Get("config").([]interface{})),
+	}
+}
+
+func setApplicationData(data *schema.ResourceData, application *api.Application) diag.Diagnostics {
+	data.SetId(application.ID)
+	err := data.Set("name", application.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("description", application.Description)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("network_item_id", application.NetworkItemId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("network_item_type", application.NetworkItemType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routes := make([]map[string]interface{}, len(application.Routes))
+	for i, route := range application.Routes {
+		routes[i] = map[string]interface{}{
+			"domain":            route.Value,
+			"allow_embedded_ip": route.AllowEmbeddedIp,
+		}
+	}
+	err = data.Set("routes", routes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("config", flattenServiceConfig(application.Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+func TestResourceApplication_basic(t *testing.T) {
+	resourceName := "openvpn_application.test"
+	applicationName := "app-" + RandomString(7)
+
+	client := getAuthenticatedClient(t)
+
+	host := createTestHost(t, client, getDefaultRegionID(t, client))
+	t.Cleanup(func() {
+		deleteTestHost(t, client, host.ID)
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		CheckDestroy:      testAccCheckApplicationDestroy(client),
+		Steps: []resource.TestStep{
+			{
+				Config: resourceApplicationOutputConfig("test", applicationName, host.ID, applicationName+".example.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", applicationName),
+					resource.TestCheckResourceAttr(resourceName, "network_item_id", host.ID),
+					resource.TestCheckResourceAttr(resourceName, "routes.0.domain", applicationName+".example.com"),
+					resource.TestCheckResourceAttr(resourceName, "routes.0.allow_embedded_ip", "false"),
+				),
+			},
+			{
+				Config: resourceApplicationOutputConfig("test", applicationName, host.ID, "*."+applicationName+".example.com", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "routes.0.domain", "*."+applicationName+".example.com"),
+					resource.TestCheckResourceAttr(resourceName, "routes.0.allow_embedded_ip", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceApplication_serviceConfigCheckedAtPlan(t *testing.T) {
+	// given
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":              "application",
+		"network_item_id":   "host-id",
+		"network_item_type": "HOST",
+		"routes":            []interface{}{map[string]interface{}{"domain": "app.example.com"}},
+		"config": []interface{}{
+			map[string]interface{}{
+				"custom_service_types": []interface{}{
+					map[string]interface{}{
+						"protocol": "ICMP",
+						"port":     []interface{}{map[string]interface{}{"from": 80, "to": 80}},
+					},
+				},
+			},
+		},
+	})
+
+	// when
+	_, err := resourceApplication().Diff(context.Background(), nil, config, nil)
+
+	// then
+	assert.EqualError(t, err, "config.0.custom_service_types.0: port can not be set for protocol ICMP, use icmp_type")
+}
+
+func TestResourceApplication_removeServiceConfig(t *testing.T) {
+	// given
+	state := &terraform.InstanceState{
+		ID: "application-id",
+		Attributes: map[string]string{
+			"id":                              "application-id",
+			"name":                            "application",
+			"network_item_id":                 "host-id",
+			"network_item_type":               "HOST",
+			"routes.#":                        "1",
+			"routes.0.domain":                 "app.example.com",
+			"routes.0.allow_embedded_ip":      "false",
+			"config.#":                        "1",
+			"config.0.service_types.#":        "0",
+			"config.0.custom_service_types.#": "1",
+			"config.0.custom_service_types.0.protocol": "TCP",
+		},
+	}
+	raw := map[string]interface{}{
+		"name":              "application",
+		"network_item_id":   "host-id",
+		"network_item_type": "HOST",
+		"routes":            []interface{}{map[string]interface{}{"domain": "app.example.com"}},
+	}
+
+	// when
+	diff, err := resourceApplication().Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	request := makeApplicationRequest(schema.TestResourceDataRaw(t, resourceApplication().Schema, raw))
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	require.Contains(t, diff.Attributes, "config.#")
+	assert.Equal(t, "0", diff.Attributes["config.#"].New)
+	assert.Equal(t, &api.ServiceConfig{ServiceTypes: []string{}, CustomServiceTypes: []api.CustomServiceType{}}, request.Config)
+}
+
+func testAccCheckApplicationDestroy(client *api.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openvpn_application" {
+				continue
+			}
+
+			_, err := client.GetApplication(context.Background(), rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("application %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func resourceApplicationOutputConfig(name, applicationName, hostID, domain string, allowEmbeddedIP bool) string {
+	return fmt.Sprintf(`
+provider "openvpn" {}
+
+resource "openvpn_application" "%s" {
+	name = "%s"
+	network_item_id = "%s"
+	network_item_type = "HOST"
+
+	routes {
+		domain = "%s"
+		allow_embedded_ip = %t
+	}
+
+	config {
+		service_types = ["HTTPS"]
+	}
+}
+`, name, applicationName, hostID, domain, allowEmbeddedIP)
+}
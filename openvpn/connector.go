@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"terraform-provider-openvpn/openvpn/api"
 )
@@ -14,6 +15,15 @@ func resourceConnector() *schema.Resource {
 		ReadContext:   resourceConnectorRead,
 		UpdateContext: resourceConnectorUpdate,
 		DeleteContext: resourceConnectorDelete,
+		CustomizeDiff: customdiff.All(customizeDiffVpnRegionID, customizeDiffConnectorProfile),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceConnectorV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceConnectorStateUpgradeV0,
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -52,8 +62,86 @@ func resourceConnector() *schema.Resource {
 				Required: true,
 			},
 			"profile": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"parsed_profile": parsedProfileSchema(),
+			"profile_rotation_trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"store_profile": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func parsedProfileSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"remote": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"host": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+							"port": {
+								Type:     schema.TypeInt,
+								Computed: true,
+							},
+							"protocol": {
+								Type:     schema.TypeString,
+								Computed: true,
+							},
+						},
+					},
+				},
+				"protocol": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"cipher": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"data_ciphers": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem:     &schema.Schema{Type: schema.TypeString},
+				},
+				"auth": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"ca": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"cert": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"key": {
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
+				"tls_crypt": {
+					Type:      schema.TypeString,
+					Computed:  true,
+					Sensitive: true,
+				},
 			},
 		},
 	}
@@ -80,7 +168,7 @@ func resourceConnectorUpdate(ctx context.Context, data *schema.ResourceData, i i
 
 	connector, err := client.UpdateConnector(ctx, connectorId, request)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAuthError(err)
 	}
 
 	diagnostics := setConnectorData(data, connector)
@@ -88,16 +176,8 @@ func resourceConnectorUpdate(ctx context.Context, data *schema.ResourceData, i i
 		return diagnostics
 	}
 
-	connectorProfile, err := client.GetConnectorProfile(ctx, connector.ID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	err = data.Set("profile", connectorProfile)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
+	regenerate := data.HasChanges("profile_rotation_trigger", "vpn_region_id")
+	return setConnectorProfile(ctx, data, client, connector.ID, regenerate)
 }
 
 func resourceConnectorDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -123,7 +203,7 @@ func resourceConnectorDelete(ctx context.Context, data *schema.ResourceData, i i
 
 	err := client.DeleteConnector(ctx, networkItemId, api.NetworkItemType(networkItemType), connectorId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAuthError(err)
 	}
 
 	return nil
@@ -145,7 +225,7 @@ func resourceConnectorCreate(ctx context.Context, data *schema.ResourceData, i i
 
 	connector, err := client.CreateConnector(ctx, request)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAuthError(err)
 	}
 
 	diagnostics := setConnectorData(data, connector)
@@ -153,16 +233,7 @@ func resourceConnectorCreate(ctx context.Context, data *schema.ResourceData, i i
 		return diagnostics
 	}
 
-	connectorProfile, err := client.GetConnectorProfile(ctx, connector.ID)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	err = data.Set("profile", connectorProfile)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
-	return nil
+	return setConnectorProfile(ctx, data, client, connector.ID, true)
 }
 
 func resourceConnectorRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
@@ -178,7 +249,7 @@ func resourceConnectorRead(ctx context.Context, data *schema.ResourceData, i int
 
 	connector, err := client.GetConnector(ctx, connectorId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAuthError(err)
 	}
 
 	diagnostics := setConnectorData(data, connector)
@@ -186,16 +257,108 @@ func resourceConnectorRead(ctx context.Context, data *schema.ResourceData, i int
 		return diagnostics
 	}
 
-	connectorProfile, err := client.GetConnectorProfile(ctx, connector.ID)
+	return setConnectorProfile(ctx, data, client, connector.ID, false)
+}
+
+// customizeDiffConnectorProfile plans the profile as unknown when the update regenerates it, so resources using
+// the profile are not planned with the one about to be revoked.
+func customizeDiffConnectorProfile(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if diff.Id() == "" || !diff.Get("store_profile").(bool) || (!diff.HasChange("profile_rotation_trigger") && !diff.HasChange("vpn_region_id")) {
+		return nil
+	}
+
+	err := diff.SetNewComputed("profile")
+	if err != nil {
+		return err
+	}
+	return diff.SetNewComputed("parsed_profile")
+}
+
+// setConnectorProfile fetches a new connector profile only when regenerate is set or none is known yet,
+// fetching the profile issues new credentials so it is not done on every read.
+// Nothing is fetched and the profile is kept out of the state when store_profile is disabled.
+func setConnectorProfile(ctx context.Context, data *schema.ResourceData, client *api.Client, connectorID string, regenerate bool) diag.Diagnostics {
+	if !data.Get("store_profile").(bool) {
+		return setConnectorProfileData(data, "")
+	}
+
+	connectorProfile := data.Get("profile").(string)
+	var err error
+	if regenerate {
+		connectorProfile, err = client.RegenerateConnectorProfile(ctx, connectorID)
+	} else if connectorProfile == "" {
+		connectorProfile, err = client.GetConnectorProfile(ctx, connectorID)
+	}
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setConnectorProfileData(data, connectorProfile)
+}
+
+func setConnectorProfileData(data *schema.ResourceData, connectorProfile string) diag.Diagnostics {
+	err := data.Set("profile", connectorProfile)
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	err = data.Set("profile", connectorProfile)
+
+	parsedProfile, diagnostics := parseConnectorProfile(connectorProfile)
+	err = data.Set("parsed_profile", parsedProfile)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	return nil
+	return diagnostics
+}
+
+// parseConnectorProfile flattens the profile for parsed_profile. A profile the parser does not understand only
+// leaves parsed_profile empty with a warning, so a change of the profile format does not break reading resources.
+func parseConnectorProfile(connectorProfile string) ([]interface{}, diag.Diagnostics) {
+	parsedProfile, err := flattenConnectorProfile(connectorProfile)
+	if err != nil {
+		return []interface{}{}, diag.Diagnostics{
+			{
+				Severity: diag.Warning,
+				Summary:  "unable to parse the connector profile",
+				Detail:   "parsed_profile is left empty, the profile is still available in profile: " + err.Error(),
+			},
+		}
+	}
+	return parsedProfile, nil
+}
+
+func flattenConnectorProfile(connectorProfile string) ([]interface{}, error) {
+	if connectorProfile == "" {
+		return []interface{}{}, nil
+	}
+
+	profile, err := api.ParseConnectorProfile(connectorProfile)
+	if err != nil {
+		return nil, err
+	}
+
+	remotes := make([]interface{}, len(profile.Remotes))
+	for i, remote := range profile.Remotes {
+		remotes[i] = map[string]interface{}{
+			"host":     remote.Host,
+			"port":     remote.Port,
+			"protocol": remote.Protocol,
+		}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"remote":       remotes,
+			"protocol":     profile.Protocol,
+			"cipher":       profile.Cipher,
+			"data_ciphers": profile.DataCiphers,
+			"auth":         profile.Auth,
+			"ca":           profile.CA,
+			"cert":         profile.Cert,
+			"key":          profile.Key,
+			"tls_crypt":    profile.TLSCrypt,
+		},
+	}, nil
 }
 
 func setConnectorData(data *schema.ResourceData, connector *api.Connector) diag.Diagnostics {
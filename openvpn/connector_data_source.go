@@ -48,9 +48,11 @@ func dataSourceConnector() *schema.Resource {
 				Computed: true,
 			},
 			"profile": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
+			"parsed_profile": parsedProfileSchema(),
 		},
 	}
 }
@@ -68,19 +70,15 @@ func dataSourceConnectorRead(ctx context.Context, data *schema.ResourceData, i i
 
 	connector, err := client.GetConnector(ctx, connectorId)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAuthError(err)
 	}
 
 	setConnectorData(data, connector)
 
 	connectorProfile, err := client.GetConnectorProfile(ctx, connectorId)
 	if err != nil {
-		return diag.FromErr(err)
-	}
-	err = data.Set("profile", connectorProfile)
-	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAuthError(err)
 	}
 
-	return nil
+	return setConnectorProfileData(data, connectorProfile)
 }
@@ -0,0 +1,132 @@
+package openvpn
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"os"
+	"path/filepath"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+const connectorProfileFilePermission = 0600
+
+// resourceConnectorProfile writes a connector profile to a local file instead of keeping it in the state,
+// only a checksum of the written file is stored.
+func resourceConnectorProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceConnectorProfileCreate,
+		ReadContext:   resourceConnectorProfileRead,
+		DeleteContext: resourceConnectorProfileDelete,
+		Schema: map[string]*schema.Schema{
+			"connector_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"filename": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"profile_rotation_trigger": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"content_sha256": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceConnectorProfileCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	connectorId := data.Get("connector_id").(string)
+	filename := data.Get("filename").(string)
+
+	// a rotation replaces the resource, the cached profile may be the one the rotation is meant to revoke
+	var connectorProfile string
+	var err error
+	if len(data.Get("profile_rotation_trigger").(map[string]interface{})) > 0 {
+		connectorProfile, err = client.RegenerateConnectorProfile(ctx, connectorId)
+	} else {
+		connectorProfile, err = client.GetConnectorProfile(ctx, connectorId)
+	}
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	err = writeConnectorProfileFile(filename, connectorProfile)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	data.SetId(connectorId)
+	err = data.Set("content_sha256", sha256Hex([]byte(connectorProfile)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceConnectorProfileRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	filename := data.Get("filename").(string)
+
+	content, err := os.ReadFile(filename)
+	if os.IsNotExist(err) {
+		data.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The profile is written again when the file was changed outside of Terraform.
+	if sha256Hex(content) != data.Get("content_sha256").(string) {
+		data.SetId("")
+	}
+
+	return nil
+}
+
+func resourceConnectorProfileDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	filename := data.Get("filename").(string)
+
+	err := os.Remove(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func writeConnectorProfileFile(filename, connectorProfile string) error {
+	err := os.MkdirAll(filepath.Dir(filename), 0700)
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(filename, []byte(connectorProfile), connectorProfileFilePermission)
+	if err != nil {
+		return err
+	}
+
+	// WriteFile keeps the permissions of an already existing file.
+	return os.Chmod(filename, connectorProfileFilePermission)
+}
+
+func sha256Hex(content []byte) string {
+	checksum := sha256.Sum256(content)
+	return hex.EncodeToString(checksum[:])
+}
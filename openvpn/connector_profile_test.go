@@ -0,0 +1,127 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+func TestResourceConnectorProfile_basic(t *testing.T) {
+	resourceName := "openvpn_connector_profile.test"
+
+	client := getAuthenticatedClient(t)
+
+	regionId := getDefaultRegionID(t, client)
+	host := createTestHost(t, client, regionId)
+
+	t.Cleanup(func() {
+		deleteTestHost(t, client, host.ID)
+	})
+
+	filename := filepath.Join(t.TempDir(), "profiles", "connector.ovpn")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		CheckDestroy:      testAccCheckConnectorProfileFileRemoved(filename),
+		Steps: []resource.TestStep{
+			{
+				Config: resourceConnectorProfileOutputConfig("test", host.Connectors[0].ID, filename),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", host.Connectors[0].ID),
+					resource.TestCheckResourceAttrSet(resourceName, "content_sha256"),
+					testCheckConnectorProfileFile(filename),
+				),
+			},
+		},
+	})
+}
+
+func TestWriteConnectorProfileFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "connector.ovpn")
+	err := os.WriteFile(filename, []byte("old"), 0644)
+	require.NoError(t, err)
+
+	err = writeConnectorProfileFile(filename, "client\n")
+	require.NoError(t, err)
+
+	info, err := os.Stat(filename)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+}
+
+func TestResourceConnectorProfileCreate_rotation(t *testing.T) {
+	// given
+	var generated int32
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		_, _ = fmt.Fprintf(writer, "profile-%d", atomic.AddInt32(&generated, 1))
+	}))
+	t.Cleanup(server.Close)
+	client := api.NewClient(&http.Client{}, &api.AuthConfig{Host: server.URL, AccessToken: "token"})
+
+	cached, err := client.GetConnectorProfile(context.Background(), "connector-id")
+	require.NoError(t, err)
+	filename := filepath.Join(t.TempDir(), "connector.ovpn")
+	data := schema.TestResourceDataRaw(t, resourceConnectorProfile().Schema, map[string]interface{}{
+		"connector_id":             "connector-id",
+		"filename":                 filename,
+		"profile_rotation_trigger": map[string]interface{}{"rotated": "1"},
+	})
+
+	// when
+	diagnostics := resourceConnectorProfileCreate(context.Background(), data, client)
+
+	// then
+	require.Nil(t, diagnostics)
+	content, err := os.ReadFile(filename)
+	require.NoError(t, err)
+	assert.Equal(t, "profile-1", cached)
+	assert.Equal(t, "profile-2", string(content))
+}
+
+func resourceConnectorProfileOutputConfig(name, connectorId, filename string) string {
+	return fmt.Sprintf(`
+provider "openvpn" {}
+
+resource "openvpn_connector_profile" "%s" {
+	connector_id = "%s"
+	filename = "%s"
+}
+`, name, connectorId, filename)
+}
+
+func testCheckConnectorProfileFile(filename string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		if info.Mode().Perm() != 0600 {
+			return fmt.Errorf("profile file has permissions %o, expected 600", info.Mode().Perm())
+		}
+		return nil
+	}
+}
+
+func testAccCheckConnectorProfileFileRemoved(filename string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		_, err := os.Stat(filename)
+		if !os.IsNotExist(err) {
+			return fmt.Errorf("profile file %s still exists", filename)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,78 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceConnectorV0 is the openvpn_connector schema before the profile became sensitive
+// and could be rotated or kept out of the state.
+func resourceConnectorV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ip_v4_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_v6_address": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"network_item_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"network_item_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"vpn_region_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"profile": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceConnectorStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	return upgradeConnectorStateV0(rawState)
+}
+
+// upgradeConnectorStateV0 fills in the attributes added in version 1 so that existing connectors don't show a diff.
+func upgradeConnectorStateV0(rawState map[string]interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	if _, ok := rawState["store_profile"]; !ok {
+		rawState["store_profile"] = true
+	}
+
+	if _, ok := rawState["parsed_profile"]; !ok {
+		// a profile the parser rejects must not block the upgrade, Read parses it again and warns about it
+		connectorProfile, _ := rawState["profile"].(string)
+		parsedProfile, err := flattenConnectorProfile(connectorProfile)
+		if err != nil {
+			parsedProfile = []interface{}{}
+		}
+		rawState["parsed_profile"] = parsedProfile
+	}
+
+	return rawState, nil
+}
@@ -0,0 +1,47 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestResourceConnectorStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":      "connector-id",
+		"profile": "remote vpn.example.com 443 tcp\n",
+	}
+
+	upgradedState, err := resourceConnectorStateUpgradeV0(context.Background(), rawState, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, true, upgradedState["store_profile"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{
+			"remote": []interface{}{
+				map[string]interface{}{"host": "vpn.example.com", "port": 443, "protocol": "tcp"},
+			},
+			"protocol":     "",
+			"cipher":       "",
+			"data_ciphers": []string(nil),
+			"auth":         "",
+			"ca":           "",
+			"cert":         "",
+			"key":          "",
+			"tls_crypt":    "",
+		},
+	}, upgradedState["parsed_profile"])
+}
+
+func TestResourceConnectorStateUpgradeV0_unparsableProfile(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id":      "connector-id",
+		"profile": "remote vpn.example.com port\n",
+	}
+
+	upgradedState, err := resourceConnectorStateUpgradeV0(context.Background(), rawState, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "remote vpn.example.com port\n", upgradedState["profile"])
+	assert.Equal(t, []interface{}{}, upgradedState["parsed_profile"])
+}
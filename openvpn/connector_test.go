@@ -3,8 +3,12 @@ package openvpn
 import (
 	"context"
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"regexp"
 	"terraform-provider-openvpn/openvpn/api"
 	"testing"
@@ -85,6 +89,110 @@ func TestResourceConnector_invalidNetworkItemType(t *testing.T) {
 	})
 }
 
+func TestFlattenConnectorProfile(t *testing.T) {
+	parsedProfile, err := flattenConnectorProfile("proto tcp\nremote vpn.example.com 443\ncipher AES-256-GCM\n<key>\nKEY\n</key>\n")
+	require.NoError(t, err)
+
+	require.Len(t, parsedProfile, 1)
+	profileData := parsedProfile[0].(map[string]interface{})
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"host": "vpn.example.com", "port": 443, "protocol": "tcp"},
+	}, profileData["remote"])
+	assert.Equal(t, "AES-256-GCM", profileData["cipher"])
+	assert.Equal(t, "KEY\n", profileData["key"])
+
+	emptyProfile, err := flattenConnectorProfile("")
+	assert.NoError(t, err)
+	assert.Empty(t, emptyProfile)
+}
+
+func TestSetConnectorProfileData_unparsableProfile(t *testing.T) {
+	// given
+	data := schema.TestResourceDataRaw(t, resourceConnector().Schema, map[string]interface{}{})
+
+	// when
+	diagnostics := setConnectorProfileData(data, "<ca>\nCA\n")
+
+	// then
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, diag.Warning, diagnostics[0].Severity)
+	assert.Equal(t, "<ca>\nCA\n", data.Get("profile"))
+	assert.Empty(t, data.Get("parsed_profile"))
+}
+
+func TestGetConnectorsListItem_unparsableProfile(t *testing.T) {
+	// given
+	existing := map[string]interface{}{"id": "connector-id", "store_profile": true, "profile": "remote\n"}
+
+	// when
+	connectorData, diagnostics := getConnectorsListItem(context.Background(), nil, api.Connector{ID: "connector-id"}, existing, false)
+
+	// then
+	require.Len(t, diagnostics, 1)
+	assert.Equal(t, diag.Warning, diagnostics[0].Severity)
+	assert.Equal(t, "remote\n", connectorData["profile"])
+	assert.Empty(t, connectorData["parsed_profile"])
+}
+
+func TestCustomizeDiffConnectorProfile(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "connector-id",
+		Attributes: map[string]string{
+			"id":                         "connector-id",
+			"name":                       "connector",
+			"description":                "description",
+			"network_item_id":            "network-id",
+			"network_item_type":          "NETWORK",
+			"vpn_region_id":              "us-east-1",
+			"profile":                    "proto tcp\n",
+			"parsed_profile.#":           "0",
+			"profile_rotation_trigger.%": "1",
+			"profile_rotation_trigger.k": "a",
+			"store_profile":              "true",
+		},
+	}
+	config := map[string]interface{}{
+		"name":                     "connector",
+		"description":              "description",
+		"network_item_id":          "network-id",
+		"network_item_type":        "NETWORK",
+		"vpn_region_id":            "us-east-1",
+		"profile_rotation_trigger": map[string]interface{}{"k": "a"},
+	}
+
+	for name, test := range map[string]struct {
+		change   map[string]interface{}
+		computed bool
+	}{
+		"no change":          {change: map[string]interface{}{}, computed: false},
+		"name changed":       {change: map[string]interface{}{"name": "renamed"}, computed: false},
+		"rotation trigger":   {change: map[string]interface{}{"profile_rotation_trigger": map[string]interface{}{"k": "b"}}, computed: true},
+		"region changed":     {change: map[string]interface{}{"vpn_region_id": "eu-central-1"}, computed: true},
+		"profile not stored": {change: map[string]interface{}{"vpn_region_id": "eu-central-1", "store_profile": false}, computed: false},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			raw := map[string]interface{}{}
+			for key, value := range config {
+				raw[key] = value
+			}
+			for key, value := range test.change {
+				raw[key] = value
+			}
+
+			// when
+			diff, err := resourceConnector().Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+
+			// then
+			require.NoError(t, err)
+			for _, key := range []string{"profile", "parsed_profile.#"} {
+				computed := diff != nil && diff.Attributes[key] != nil && diff.Attributes[key].NewComputed
+				assert.Equal(t, test.computed, computed, key)
+			}
+		})
+	}
+}
+
 func resourceConnectorOutputConfig(name, networkItemId string, networkItemType api.NetworkItemType, request *api.CreateConnectorData) string {
 	return fmt.Sprintf(`
 provider "openvpn" {}
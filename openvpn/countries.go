@@ -0,0 +1,261 @@
+package openvpn
+
+type country struct {
+	Continent string
+	Latitude  float64
+	Longitude float64
+}
+
+// countries maps ISO 3166-1 alpha-2 codes, as used by api.Region.CountryISO,
+// to the continent and the approximate geographic center of the country.
+var countries = map[string]country{
+	"AD": {"Europe", 42.55, 1.6},
+	"AE": {"Asia", 23.42, 53.85},
+	"AF": {"Asia", 33.94, 67.71},
+	"AG": {"North America", 17.06, -61.8},
+	"AI": {"North America", 18.22, -63.07},
+	"AL": {"Europe", 41.15, 20.17},
+	"AM": {"Asia", 40.07, 45.04},
+	"AO": {"Africa", -11.2, 17.87},
+	"AQ": {"Antarctica", -75.25, -0.07},
+	"AR": {"South America", -38.42, -63.62},
+	"AS": {"Oceania", -14.27, -170.13},
+	"AT": {"Europe", 47.52, 14.55},
+	"AU": {"Oceania", -25.27, 133.78},
+	"AW": {"North America", 12.52, -69.97},
+	"AX": {"Europe", 60.18, 19.92},
+	"AZ": {"Asia", 40.14, 47.58},
+	"BA": {"Europe", 43.92, 17.68},
+	"BB": {"North America", 13.19, -59.54},
+	"BD": {"Asia", 23.68, 90.36},
+	"BE": {"Europe", 50.5, 4.47},
+	"BF": {"Africa", 12.24, -1.56},
+	"BG": {"Europe", 42.73, 25.49},
+	"BH": {"Asia", 25.93, 50.64},
+	"BI": {"Africa", -3.37, 29.92},
+	"BJ": {"Africa", 9.31, 2.32},
+	"BL": {"North America", 17.9, -62.83},
+	"BM": {"North America", 32.32, -64.76},
+	"BN": {"Asia", 4.54, 114.73},
+	"BO": {"South America", -16.29, -63.59},
+	"BQ": {"North America", 12.18, -68.24},
+	"BR": {"South America", -14.24, -51.93},
+	"BS": {"North America", 25.03, -77.4},
+	"BT": {"Asia", 27.51, 90.43},
+	"BV": {"Antarctica", -54.42, 3.41},
+	"BW": {"Africa", -22.33, 24.68},
+	"BY": {"Europe", 53.71, 27.95},
+	"BZ": {"North America", 17.19, -88.5},
+	"CA": {"North America", 56.13, -106.35},
+	"CC": {"Oceania", -12.16, 96.87},
+	"CD": {"Africa", -4.04, 21.76},
+	"CF": {"Africa", 6.61, 20.94},
+	"CG": {"Africa", -0.23, 15.83},
+	"CH": {"Europe", 46.82, 8.23},
+	"CI": {"Africa", 7.54, -5.55},
+	"CK": {"Oceania", -21.24, -159.78},
+	"CL": {"South America", -35.68, -71.54},
+	"CM": {"Africa", 7.37, 12.35},
+	"CN": {"Asia", 35.86, 104.2},
+	"CO": {"South America", 4.57, -74.3},
+	"CR": {"North America", 9.75, -83.75},
+	"CU": {"North America", 21.52, -77.78},
+	"CV": {"Africa", 16.0, -24.01},
+	"CW": {"North America", 12.17, -68.99},
+	"CX": {"Oceania", -10.45, 105.69},
+	"CY": {"Europe", 35.13, 33.43},
+	"CZ": {"Europe", 49.82, 15.47},
+	"DE": {"Europe", 51.17, 10.45},
+	"DJ": {"Africa", 11.83, 42.59},
+	"DK": {"Europe", 56.26, 9.5},
+	"DM": {"North America", 15.41, -61.37},
+	"DO": {"North America", 18.74, -70.16},
+	"DZ": {"Africa", 28.03, 1.66},
+	"EC": {"South America", -1.83, -78.18},
+	"EE": {"Europe", 58.6, 25.01},
+	"EG": {"Africa", 26.82, 30.8},
+	"EH": {"Africa", 24.22, -12.89},
+	"ER": {"Africa", 15.18, 39.78},
+	"ES": {"Europe", 40.46, -3.75},
+	"ET": {"Africa", 9.15, 40.49},
+	"FI": {"Europe", 61.92, 25.75},
+	"FJ": {"Oceania", -16.58, 179.41},
+	"FK": {"South America", -51.8, -59.52},
+	"FM": {"Oceania", 7.43, 150.55},
+	"FO": {"Europe", 61.89, -6.91},
+	"FR": {"Europe", 46.23, 2.21},
+	"GA": {"Africa", -0.8, 11.61},
+	"GB": {"Europe", 55.38, -3.44},
+	"GD": {"North America", 12.26, -61.6},
+	"GE": {"Asia", 42.32, 43.36},
+	"GF": {"South America", 3.93, -53.13},
+	"GG": {"Europe", 49.47, -2.59},
+	"GH": {"Africa", 7.95, -1.02},
+	"GI": {"Europe", 36.14, -5.35},
+	"GL": {"North America", 71.71, -42.6},
+	"GM": {"Africa", 13.44, -15.31},
+	"GN": {"Africa", 9.95, -9.7},
+	"GP": {"North America", 16.27, -61.55},
+	"GQ": {"Africa", 1.65, 10.27},
+	"GR": {"Europe", 39.07, 21.82},
+	"GS": {"Antarctica", -54.43, -36.59},
+	"GT": {"North America", 15.78, -90.23},
+	"GU": {"Oceania", 13.44, 144.79},
+	"GW": {"Africa", 11.8, -15.18},
+	"GY": {"South America", 4.86, -58.93},
+	"HK": {"Asia", 22.4, 114.11},
+	"HM": {"Antarctica", -53.08, 73.5},
+	"HN": {"North America", 15.2, -86.24},
+	"HR": {"Europe", 45.1, 15.2},
+	"HT": {"North America", 18.97, -72.29},
+	"HU": {"Europe", 47.16, 19.5},
+	"ID": {"Asia", -0.79, 113.92},
+	"IE": {"Europe", 53.41, -8.24},
+	"IL": {"Asia", 31.05, 34.85},
+	"IM": {"Europe", 54.24, -4.55},
+	"IN": {"Asia", 20.59, 78.96},
+	"IO": {"Asia", -6.34, 71.88},
+	"IQ": {"Asia", 33.22, 43.68},
+	"IR": {"Asia", 32.43, 53.69},
+	"IS": {"Europe", 64.96, -19.02},
+	"IT": {"Europe", 41.87, 12.57},
+	"JE": {"Europe", 49.21, -2.13},
+	"JM": {"North America", 18.11, -77.3},
+	"JO": {"Asia", 30.59, 36.24},
+	"JP": {"Asia", 36.2, 138.25},
+	"KE": {"Africa", -0.02, 37.91},
+	"KG": {"Asia", 41.2, 74.77},
+	"KH": {"Asia", 12.57, 104.99},
+	"KI": {"Oceania", -3.37, -168.73},
+	"KM": {"Africa", -11.88, 43.87},
+	"KN": {"North America", 17.36, -62.78},
+	"KP": {"Asia", 40.34, 127.51},
+	"KR": {"Asia", 35.91, 127.77},
+	"KW": {"Asia", 29.31, 47.48},
+	"KY": {"North America", 19.51, -80.57},
+	"KZ": {"Asia", 48.02, 66.92},
+	"LA": {"Asia", 19.86, 102.5},
+	"LB": {"Asia", 33.85, 35.86},
+	"LC": {"North America", 13.91, -60.98},
+	"LI": {"Europe", 47.17, 9.56},
+	"LK": {"Asia", 7.87, 80.77},
+	"LR": {"Africa", 6.43, -9.43},
+	"LS": {"Africa", -29.61, 28.23},
+	"LT": {"Europe", 55.17, 23.88},
+	"LU": {"Europe", 49.82, 6.13},
+	"LV": {"Europe", 56.88, 24.6},
+	"LY": {"Africa", 26.34, 17.23},
+	"MA": {"Africa", 31.79, -7.09},
+	"MC": {"Europe", 43.75, 7.41},
+	"MD": {"Europe", 47.41, 28.37},
+	"ME": {"Europe", 42.71, 19.37},
+	"MF": {"North America", 18.08, -63.05},
+	"MG": {"Africa", -18.77, 46.87},
+	"MH": {"Oceania", 7.13, 171.18},
+	"MK": {"Europe", 41.61, 21.75},
+	"ML": {"Africa", 17.57, -4.0},
+	"MM": {"Asia", 21.91, 95.96},
+	"MN": {"Asia", 46.86, 103.85},
+	"MO": {"Asia", 22.2, 113.54},
+	"MP": {"Oceania", 17.33, 145.38},
+	"MQ": {"North America", 14.64, -61.02},
+	"MR": {"Africa", 21.01, -10.94},
+	"MS": {"North America", 16.74, -62.19},
+	"MT": {"Europe", 35.94, 14.38},
+	"MU": {"Africa", -20.35, 57.55},
+	"MV": {"Asia", 3.2, 73.22},
+	"MW": {"Africa", -13.25, 34.3},
+	"MX": {"North America", 23.63, -102.55},
+	"MY": {"Asia", 4.21, 101.98},
+	"MZ": {"Africa", -18.67, 35.53},
+	"NA": {"Africa", -22.96, 18.49},
+	"NC": {"Oceania", -20.9, 165.62},
+	"NE": {"Africa", 17.61, 8.08},
+	"NF": {"Oceania", -29.04, 167.95},
+	"NG": {"Africa", 9.08, 8.68},
+	"NI": {"North America", 12.87, -85.21},
+	"NL": {"Europe", 52.13, 5.29},
+	"NO": {"Europe", 60.47, 8.47},
+	"NP": {"Asia", 28.39, 84.12},
+	"NR": {"Oceania", -0.52, 166.93},
+	"NU": {"Oceania", -19.05, -169.87},
+	"NZ": {"Oceania", -40.9, 174.89},
+	"OM": {"Asia", 21.51, 55.92},
+	"PA": {"North America", 8.54, -80.78},
+	"PE": {"South America", -9.19, -75.02},
+	"PF": {"Oceania", -17.68, -149.41},
+	"PG": {"Oceania", -6.31, 143.96},
+	"PH": {"Asia", 12.88, 121.77},
+	"PK": {"Asia", 30.38, 69.35},
+	"PL": {"Europe", 51.92, 19.15},
+	"PM": {"North America", 46.94, -56.27},
+	"PN": {"Oceania", -24.7, -127.44},
+	"PR": {"North America", 18.22, -66.59},
+	"PS": {"Asia", 31.95, 35.23},
+	"PT": {"Europe", 39.4, -8.22},
+	"PW": {"Oceania", 7.51, 134.58},
+	"PY": {"South America", -23.44, -58.44},
+	"QA": {"Asia", 25.35, 51.18},
+	"RE": {"Africa", -21.12, 55.54},
+	"RO": {"Europe", 45.94, 24.97},
+	"RS": {"Europe", 44.02, 21.01},
+	"RU": {"Europe", 61.52, 105.32},
+	"RW": {"Africa", -1.94, 29.87},
+	"SA": {"Asia", 23.89, 45.08},
+	"SB": {"Oceania", -9.65, 160.16},
+	"SC": {"Africa", -4.68, 55.49},
+	"SD": {"Africa", 12.86, 30.22},
+	"SE": {"Europe", 60.13, 18.64},
+	"SG": {"Asia", 1.35, 103.82},
+	"SH": {"Africa", -24.14, -10.03},
+	"SI": {"Europe", 46.15, 14.99},
+	"SJ": {"Europe", 77.55, 23.67},
+	"SK": {"Europe", 48.67, 19.7},
+	"SL": {"Africa", 8.46, -11.78},
+	"SM": {"Europe", 43.94, 12.46},
+	"SN": {"Africa", 14.5, -14.45},
+	"SO": {"Africa", 5.15, 46.2},
+	"SR": {"South America", 3.92, -56.03},
+	"SS": {"Africa", 6.88, 31.31},
+	"ST": {"Africa", 0.19, 6.61},
+	"SV": {"North America", 13.79, -88.9},
+	"SX": {"North America", 18.04, -63.05},
+	"SY": {"Asia", 34.8, 39.0},
+	"SZ": {"Africa", -26.52, 31.47},
+	"TC": {"North America", 21.69, -71.8},
+	"TD": {"Africa", 15.45, 18.73},
+	"TF": {"Antarctica", -49.28, 69.35},
+	"TG": {"Africa", 8.62, 0.82},
+	"TH": {"Asia", 15.87, 100.99},
+	"TJ": {"Asia", 38.86, 71.28},
+	"TK": {"Oceania", -8.97, -171.86},
+	"TL": {"Asia", -8.87, 125.73},
+	"TM": {"Asia", 38.97, 59.56},
+	"TN": {"Africa", 33.89, 9.54},
+	"TO": {"Oceania", -21.18, -175.2},
+	"TR": {"Asia", 38.96, 35.24},
+	"TT": {"North America", 10.69, -61.22},
+	"TV": {"Oceania", -7.11, 177.65},
+	"TW": {"Asia", 23.7, 120.96},
+	"TZ": {"Africa", -6.37, 34.89},
+	"UA": {"Europe", 48.38, 31.17},
+	"UG": {"Africa", 1.37, 32.29},
+	"UM": {"Oceania", 19.28, 166.65},
+	"US": {"North America", 37.09, -95.71},
+	"UY": {"South America", -32.52, -55.77},
+	"UZ": {"Asia", 41.38, 64.59},
+	"VA": {"Europe", 41.9, 12.45},
+	"VC": {"North America", 12.98, -61.29},
+	"VE": {"South America", 6.42, -66.59},
+	"VG": {"North America", 18.42, -64.64},
+	"VI": {"North America", 18.34, -64.9},
+	"VN": {"Asia", 14.06, 108.28},
+	"VU": {"Oceania", -15.38, 166.96},
+	"WF": {"Oceania", -13.77, -177.16},
+	"WS": {"Oceania", -13.76, -172.1},
+	"YE": {"Asia", 15.55, 48.52},
+	"YT": {"Africa", -12.83, 45.17},
+	"ZA": {"Africa", -30.56, 22.94},
+	"ZM": {"Africa", -13.13, 27.85},
+	"ZW": {"Africa", -19.02, 29.15},
+}
@@ -0,0 +1,213 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+// resourceDevice manages a device enrolled by a user. Devices can not be created, the resource only blocks the
+// device and optionally deletes it on destroy, e.g. when offboarding the user.
+func resourceDevice() *schema.Resource {
+	fields := deviceFields()
+	fields["device_id"] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+		ForceNew: true,
+	}
+	fields["blocked"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	fields["delete_on_destroy"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+
+	return &schema.Resource{
+		Description: "A device enrolled by a user. The device is blocked while `blocked` is enabled. " +
+			"Destroying the resource keeps the device unless `delete_on_destroy` is enabled.",
+		CreateContext: resourceDeviceUpdate,
+		ReadContext:   resourceDeviceRead,
+		UpdateContext: resourceDeviceUpdate,
+		DeleteContext: resourceDeviceDelete,
+		Schema:        fields,
+	}
+}
+
+// deviceFields are the attributes of a device reported by the API.
+func deviceFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"name": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"description": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"user_id": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"os": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"client_version": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ip_v4_address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"ip_v6_address": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"last_connected_at": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+		"status": {
+			Type:     schema.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func resourceDeviceUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	deviceId := data.Get("device_id").(string)
+	device, err := client.GetDevice(ctx, deviceId)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	blocked := data.Get("blocked").(bool)
+	if blocked != (device.Status == api.DeviceStatusBlocked) {
+		if blocked {
+			err = client.BlockDevice(ctx, deviceId)
+		} else {
+			err = client.UnblockDevice(ctx, deviceId)
+		}
+		if err != nil {
+			return diagFromAuthError(err)
+		}
+
+		device, err = client.GetDevice(ctx, deviceId)
+		if err != nil {
+			return diagFromAuthError(err)
+		}
+	}
+
+	return setDeviceData(data, device)
+}
+
+func resourceDeviceRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	device, err := client.GetDevice(ctx, data.Id())
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setDeviceData(data, device)
+}
+
+func resourceDeviceDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	if !data.Get("delete_on_destroy").(bool) {
+		return nil
+	}
+
+	err := client.DeleteDevice(ctx, data.Id())
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return nil
+}
+
+func flattenDevice(device api.Device) map[string]interface{} {
+	return map[string]interface{}{
+		"id":                device.ID,
+		"name":              device.Name,
+		"description":       device.Description,
+		"user_id":           device.UserId,
+		"os":                device.OS,
+		"client_version":    device.ClientVersion,
+		"ip_v4_address":     device.IpV4Address,
+		"ip_v6_address":     device.IpV6Address,
+		"last_connected_at": device.LastConnectedAt,
+		"status":            string(device.Status),
+	}
+}
+
+func setDeviceData(data *schema.ResourceData, device *api.Device) diag.Diagnostics {
+	data.SetId(device.ID)
+	err := data.Set("device_id", device.ID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("name", device.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("description", device.Description)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("user_id", device.UserId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("os", device.OS)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("client_version", device.ClientVersion)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("ip_v4_address", device.IpV4Address)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("ip_v6_address", device.IpV6Address)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("last_connected_at", device.LastConnectedAt)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("status", device.Status)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("blocked", device.Status == api.DeviceStatusBlocked)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
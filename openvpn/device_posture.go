@@ -0,0 +1,286 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"regexp"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+var osVersionRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*$`)
+
+func resourceDevicePosture() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceDevicePostureCreate,
+		ReadContext:   resourceDevicePostureRead,
+		UpdateContext: resourceDevicePostureUpdate,
+		DeleteContext: resourceDevicePostureDelete,
+		CustomizeDiff: customizeDiffDevicePosture,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user_group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"windows": desktopPolicySchema(),
+			"macos":   desktopPolicySchema(),
+			"linux":   osPolicySchema(),
+			"android": osPolicySchema(),
+			"ios":     osPolicySchema(),
+		},
+	}
+}
+
+// osPolicySchema is the policy block of an operating system, the API applies its own defaults
+// to the blocks that are not configured.
+func osPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: osPolicyFields(),
+		},
+	}
+}
+
+func desktopPolicySchema() *schema.Schema {
+	fields := osPolicyFields()
+	fields["require_antivirus"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+	fields["antiviruses"] = &schema.Schema{
+		Type:     schema.TypeSet,
+		Optional: true,
+		Elem:     &schema.Schema{Type: schema.TypeString},
+	}
+	fields["require_disk_encryption"] = &schema.Schema{
+		Type:     schema.TypeBool,
+		Optional: true,
+		Default:  false,
+	}
+
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: fields,
+		},
+	}
+}
+
+func osPolicyFields() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"allowed": {
+			Type:     schema.TypeBool,
+			Required: true,
+		},
+		"min_version": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringMatch(osVersionRegexp, "must be a version like '10' or '10.15.7'"),
+		},
+	}
+}
+
+func resourceDevicePostureCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	devicePosture, err := client.CreateDevicePosture(ctx, makeDevicePostureRequest(data))
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setDevicePostureData(data, devicePosture)
+}
+
+func resourceDevicePostureRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	devicePosture, err := client.GetDevicePosture(ctx, data.Id())
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setDevicePostureData(data, devicePosture)
+}
+
+func resourceDevicePostureUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	request := api.UpdateDevicePostureRequest(*makeDevicePostureRequest(data))
+	devicePosture, err := client.UpdateDevicePosture(ctx, data.Id(), &request)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setDevicePostureData(data, devicePosture)
+}
+
+func resourceDevicePostureDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	err := client.DeleteDevicePosture(ctx, data.Id())
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return nil
+}
+
+// customizeDiffDevicePosture checks the desktop policies at plan time, antiviruses are only checked when an
+// antivirus is required.
+func customizeDiffDevicePosture(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	for _, attribute := range []string{"windows.0", "macos.0"} {
+		if !diff.NewValueKnown(attribute+".require_antivirus") || !diff.NewValueKnown(attribute+".antiviruses.#") {
+			continue
+		}
+		antiviruses, _ := diff.Get(attribute + ".antiviruses").(*schema.Set)
+		if antiviruses != nil && antiviruses.Len() > 0 && !diff.Get(attribute+".require_antivirus").(bool) {
+			return fmt.Errorf("%s: antiviruses can only be set when require_antivirus is enabled", attribute)
+		}
+	}
+	return nil
+}
+
+func makeDevicePostureRequest(data *schema.ResourceData) *api.CreateDevicePostureRequest {
+	return &api.CreateDevicePostureRequest{
+		Name:          data.Get("name").(string),
+		Description:   data.Get("description").(string),
+		UserGroupsIds: expandStringSet(data.Get("user_group_ids").(*schema.Set)),
+		Windows:       expandDesktopPolicy(data.Get("windows").([]interface{})),
+		MacOS:         expandDesktopPolicy(data.Get("macos").([]interface{})),
+		Linux:         expandOSPolicy(data.Get("linux").([]interface{})),
+		Android:       expandOSPolicy(data.Get("android").([]interface{})),
+		IOS:           expandOSPolicy(data.Get("ios").([]interface{})),
+	}
+}
+
+func expandOSPolicy(policies []interface{}) *api.OSPolicy {
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+	policyData := policies[0].(map[string]interface{})
+	return &api.OSPolicy{
+		Allowed:    policyData["allowed"].(bool),
+		MinVersion: policyData["min_version"].(string),
+	}
+}
+
+func expandDesktopPolicy(policies []interface{}) *api.DesktopPolicy {
+	if len(policies) == 0 || policies[0] == nil {
+		return nil
+	}
+	policyData := policies[0].(map[string]interface{})
+	return &api.DesktopPolicy{
+		Allowed:               policyData["allowed"].(bool),
+		MinVersion:            policyData["min_version"].(string),
+		RequireAntivirus:      policyData["require_antivirus"].(bool),
+		Antiviruses:           expandStringSet(policyData["antiviruses"].(*schema.Set)),
+		RequireDiskEncryption: policyData["require_disk_encryption"].(bool),
+	}
+}
+
+func flattenOSPolicy(policy *api.OSPolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"allowed":     policy.Allowed,
+			"min_version": policy.MinVersion,
+		},
+	}
+}
+
+func flattenDesktopPolicy(policy *api.DesktopPolicy) []interface{} {
+	if policy == nil {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"allowed":                 policy.Allowed,
+			"min_version":             policy.MinVersion,
+			"require_antivirus":       policy.RequireAntivirus,
+			"antiviruses":             policy.Antiviruses,
+			"require_disk_encryption": policy.RequireDiskEncryption,
+		},
+	}
+}
+
+// configuredPolicy keeps the policy of an operating system out of the state unless its block is configured,
+// the defaults the API applies to the other operating systems would show up as a diff otherwise.
+func configuredPolicy(data *schema.ResourceData, key string, policy []interface{}) []interface{} {
+	if len(data.Get(key).([]interface{})) == 0 {
+		return []interface{}{}
+	}
+	return policy
+}
+
+func setDevicePostureData(data *schema.ResourceData, devicePosture *api.DevicePosture) diag.Diagnostics {
+	data.SetId(devicePosture.ID)
+	err := data.Set("name", devicePosture.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("description", devicePosture.Description)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("user_group_ids", devicePosture.UserGroupsIds)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("windows", configuredPolicy(data, "windows", flattenDesktopPolicy(devicePosture.Windows)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("macos", configuredPolicy(data, "macos", flattenDesktopPolicy(devicePosture.MacOS)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("linux", configuredPolicy(data, "linux", flattenOSPolicy(devicePosture.Linux)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("android", configuredPolicy(data, "android", flattenOSPolicy(devicePosture.Android)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("ios", configuredPolicy(data, "ios", flattenOSPolicy(devicePosture.IOS)))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
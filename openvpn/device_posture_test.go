@@ -0,0 +1,196 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+func TestResourceDevicePosture_basic(t *testing.T) {
+	resourceName := "openvpn_device_posture.test"
+	devicePostureName := "dp-" + RandomString(7)
+
+	client := getAuthenticatedClient(t)
+	groupID := getTestUserGroupID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		CheckDestroy:      testAccCheckDevicePostureDestroy(client),
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDevicePostureOutputConfig("test", devicePostureName, groupID, "10.15"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", devicePostureName),
+					resource.TestCheckResourceAttr(resourceName, "macos.0.min_version", "10.15"),
+					resource.TestCheckResourceAttr(resourceName, "macos.0.require_disk_encryption", "true"),
+					resource.TestCheckResourceAttr(resourceName, "linux.0.allowed", "false"),
+				),
+			},
+			{
+				Config: resourceDevicePostureOutputConfig("test", devicePostureName, groupID, "12"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "macos.0.min_version", "12"),
+				),
+			},
+		},
+	})
+}
+
+func TestMakeDevicePostureRequest(t *testing.T) {
+	// given
+	data := schema.TestResourceDataRaw(t, resourceDevicePosture().Schema, map[string]interface{}{
+		"name":           "posture",
+		"user_group_ids": []interface{}{"group-id"},
+		"windows": []interface{}{
+			map[string]interface{}{"allowed": true, "require_antivirus": true, "antiviruses": []interface{}{"DEFENDER"}},
+		},
+		"ios": []interface{}{map[string]interface{}{"allowed": true, "min_version": "16.1"}},
+	})
+
+	// when
+	request := makeDevicePostureRequest(data)
+
+	// then
+	assert.Equal(t, &api.CreateDevicePostureRequest{
+		Name:          "posture",
+		UserGroupsIds: []string{"group-id"},
+		Windows:       &api.DesktopPolicy{Allowed: true, RequireAntivirus: true, Antiviruses: []string{"DEFENDER"}},
+		IOS:           &api.OSPolicy{Allowed: true, MinVersion: "16.1"},
+	}, request)
+
+	require.Nil(t, setDevicePostureData(data, &api.DevicePosture{
+		ID:      "id",
+		Windows: &api.DesktopPolicy{Allowed: true, RequireAntivirus: true, Antiviruses: []string{"DEFENDER"}},
+		Linux:   &api.OSPolicy{Allowed: false},
+	}))
+	assert.Equal(t, true, data.Get("windows.0.require_antivirus"))
+	assert.Empty(t, data.Get("linux"), "defaults of blocks not configured are kept out of the state")
+	assert.Empty(t, data.Get("ios"), "removed policies show up as a diff")
+}
+
+func TestResourceDevicePosture_removePolicy(t *testing.T) {
+	// given
+	state := &terraform.InstanceState{
+		ID: "posture-id",
+		Attributes: map[string]string{
+			"id":                  "posture-id",
+			"name":                "posture",
+			"user_group_ids.#":    "1",
+			"user_group_ids.0":    "group-id",
+			"linux.#":             "1",
+			"linux.0.allowed":     "false",
+			"linux.0.min_version": "",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":           "posture",
+		"user_group_ids": []interface{}{"group-id"},
+	})
+
+	// when
+	diff, err := resourceDevicePosture().Diff(context.Background(), state, config, nil)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	require.Contains(t, diff.Attributes, "linux.#")
+	assert.Equal(t, "0", diff.Attributes["linux.#"].New)
+}
+
+func TestCustomizeDiffDevicePosture(t *testing.T) {
+	for name, test := range map[string]struct {
+		windows map[string]interface{}
+		err     string
+	}{
+		"required antivirus": {
+			windows: map[string]interface{}{"allowed": true, "require_antivirus": true, "antiviruses": []interface{}{"DEFENDER"}},
+		},
+		"antiviruses without required antivirus": {
+			windows: map[string]interface{}{"allowed": true, "antiviruses": []interface{}{"DEFENDER"}},
+			err:     "windows.0: antiviruses can only be set when require_antivirus is enabled",
+		},
+		"unknown require_antivirus": {
+			windows: map[string]interface{}{"allowed": true, "require_antivirus": unknownValue, "antiviruses": []interface{}{"DEFENDER"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":           "device posture",
+				"user_group_ids": []interface{}{"group-id"},
+				"windows":        []interface{}{test.windows},
+			})
+
+			// when
+			_, err := resourceDevicePosture().Diff(context.Background(), nil, config, nil)
+
+			// then
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
+
+func TestOSPolicySchema_minVersion(t *testing.T) {
+	validate := osPolicyFields()["min_version"].ValidateFunc
+
+	for _, version := range []string{"10", "10.15.7"} {
+		_, errs := validate(version, "min_version")
+		assert.Empty(t, errs, version)
+	}
+	for _, version := range []string{"", "v10", "10.", "10..1", "latest"} {
+		_, errs := validate(version, "min_version")
+		assert.NotEmpty(t, errs, version)
+	}
+}
+
+func testAccCheckDevicePostureDestroy(client *api.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openvpn_device_posture" {
+				continue
+			}
+
+			_, err := client.GetDevicePosture(context.Background(), rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("device posture %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func resourceDevicePostureOutputConfig(name, devicePostureName, groupID, macOSMinVersion string) string {
+	return fmt.Sprintf(`
+provider "openvpn" {}
+
+resource "openvpn_device_posture" "%s" {
+	name = "%s"
+	user_group_ids = ["%s"]
+
+	macos {
+		allowed = true
+		min_version = "%s"
+		require_disk_encryption = true
+	}
+
+	linux {
+		allowed = false
+	}
+}
+`, name, devicePostureName, groupID, macOSMinVersion)
+}
@@ -0,0 +1,105 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+func TestResourceDevice_blocked(t *testing.T) {
+	resourceName := "openvpn_device.test"
+
+	client := getAuthenticatedClient(t)
+	userID := getTestUserID(t)
+
+	devices, err := client.ListUserDevices(context.Background(), userID)
+	require.NoError(t, err)
+	if len(devices) == 0 {
+		t.Skipf("user %s has no devices", userID)
+	}
+	device := devices[0]
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		CheckDestroy:      testAccCheckDeviceUnblocked(client, device.ID),
+		Steps: []resource.TestStep{
+			{
+				Config: resourceDeviceOutputConfig("test", device.ID, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", device.ID),
+					resource.TestCheckResourceAttr(resourceName, "user_id", userID),
+					resource.TestCheckResourceAttr(resourceName, "status", string(api.DeviceStatusBlocked)),
+				),
+			},
+			{
+				Config: resourceDeviceOutputConfig("test", device.ID, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "blocked", "false"),
+					resource.TestCheckResourceAttr(resourceName, "status", string(api.DeviceStatusActive)),
+				),
+			},
+		},
+	})
+}
+
+func TestSetDeviceData(t *testing.T) {
+	// given
+	data := schema.TestResourceDataRaw(t, resourceDevice().Schema, map[string]interface{}{
+		"device_id": "device-id",
+	})
+	device := &api.Device{
+		ID:              "device-id",
+		Name:            "laptop",
+		UserId:          "user-id",
+		OS:              "Windows",
+		IpV4Address:     "100.96.1.2",
+		LastConnectedAt: "2021-12-01T10:00:00Z",
+		Status:          api.DeviceStatusBlocked,
+	}
+
+	// when
+	diagnostics := setDeviceData(data, device)
+
+	// then
+	assert.Nil(t, diagnostics)
+	assert.Equal(t, "device-id", data.Id())
+	assert.Equal(t, "laptop", data.Get("name"))
+	assert.Equal(t, "Windows", data.Get("os"))
+	assert.Equal(t, "100.96.1.2", data.Get("ip_v4_address"))
+	assert.Equal(t, "2021-12-01T10:00:00Z", data.Get("last_connected_at"))
+	assert.Equal(t, true, data.Get("blocked"))
+	assert.Equal(t, false, data.Get("delete_on_destroy"))
+}
+
+func testAccCheckDeviceUnblocked(client *api.Client, deviceID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		device, err := client.GetDevice(context.Background(), deviceID)
+		if err != nil {
+			return err
+		}
+		if device.Status == api.DeviceStatusBlocked {
+			return fmt.Errorf("device %s is still blocked", deviceID)
+		}
+		return nil
+	}
+}
+
+func resourceDeviceOutputConfig(name, deviceID string, blocked bool) string {
+	return fmt.Sprintf(`
+provider "openvpn" {}
+
+resource "openvpn_device" "%s" {
+	device_id = "%s"
+	blocked = %t
+}
+`, name, deviceID, blocked)
+}
@@ -2,17 +2,31 @@ package openvpn
 
 import (
 	"context"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"terraform-provider-openvpn/openvpn/api"
 )
 
+// resourceHost manages a host and its connector. The SDK can not plan attributes of the connector block as unknown,
+// so unlike openvpn_connector a regenerated connector profile is not announced in the plan.
 func resourceHost() *schema.Resource {
 	return &schema.Resource{
+		Description: "A host with its connector. A connector profile regenerated by a change of `profile_rotation_trigger` or `vpn_region_id` " +
+			"only shows up in `connector.0.profile` after apply, disable `store_profile` and use `openvpn_connector_profile` " +
+			"when other resources depend on the profile.",
 		CreateContext: resourceHostCreate,
 		ReadContext:   resourceHostRead,
 		UpdateContext: resourceHostUpdate,
 		DeleteContext: resourceHostDelete,
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    resourceHostV0().CoreConfigSchema().ImpliedType(),
+				Upgrade: resourceHostStateUpgradeV0,
+			},
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -29,10 +43,15 @@ func resourceHost() *schema.Resource {
 			"internet_access": {
 				Type:     schema.TypeString,
 				Required: true,
+				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+					value := api.InternetAccess(i.(string))
+					return diag.FromErr(value.Validate())
+				},
 			},
 			"domain": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateDomain,
 			},
 			"system_subnets": {
 				Type:     schema.TypeList,
@@ -75,6 +94,17 @@ func resourceHost() *schema.Resource {
 							Computed:  true,
 							Sensitive: true,
 						},
+						"parsed_profile": parsedProfileSchema(),
+						"profile_rotation_trigger": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"store_profile": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Default:  true,
+						},
 					},
 				},
 			},
@@ -93,7 +123,7 @@ func resourceHostCreate(ctx context.Context, data *schema.ResourceData, i interf
 
 	host, err := client.CreateHost(ctx, request)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAuthError(err)
 	}
 
 	data.SetId(host.ID)
@@ -102,7 +132,7 @@ func resourceHostCreate(ctx context.Context, data *schema.ResourceData, i interf
 		return diag.FromErr(err)
 	}
 
-	diagnostics := setConnectorsList(ctx, data, client, host.Connectors)
+	diagnostics := setConnectorsList(ctx, data, client, host.Connectors, true)
 	if diagnostics != nil {
 		return diagnostics
 	}
@@ -115,7 +145,7 @@ func makeHostCreationRequest(data *schema.ResourceData) *api.CreateHostRequest {
 		Name:           data.Get("name").(string),
 		Description:    data.Get("description").(string),
 		Domain:         data.Get("domain").(string),
-		InternetAccess: data.Get("internet_access").(string),
+		InternetAccess: api.InternetAccess(data.Get("internet_access").(string)),
 	}
 
 	connectorsI := data.Get("connector").([]interface{})
@@ -145,7 +175,7 @@ func resourceHostRead(ctx context.Context, data *schema.ResourceData, i interfac
 
 	host, err := client.GetHost(ctx, hostID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAuthError(err)
 	}
 
 	err = data.Set("name", host.Name)
@@ -169,7 +199,7 @@ func resourceHostRead(ctx context.Context, data *schema.ResourceData, i interfac
 		return diag.FromErr(err)
 	}
 
-	diagnostics := setConnectorsList(ctx, data, client, []api.Connector{host.Connectors[0]})
+	diagnostics := setConnectorsList(ctx, data, client, []api.Connector{host.Connectors[0]}, false)
 	if diagnostics != nil {
 		return diagnostics
 	}
@@ -192,12 +222,12 @@ func resourceHostUpdate(ctx context.Context, data *schema.ResourceData, i interf
 		Name:           data.Get("name").(string),
 		Description:    data.Get("description").(string),
 		Domain:         data.Get("domain").(string),
-		InternetAccess: data.Get("internet_access").(string),
+		InternetAccess: api.InternetAccess(data.Get("internet_access").(string)),
 	}
 
 	host, err := client.UpdateHost(ctx, hostID, request)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAuthError(err)
 	}
 
 	data.SetId(host.ID)
@@ -211,7 +241,8 @@ func resourceHostUpdate(ctx context.Context, data *schema.ResourceData, i interf
 		return diagnostics
 	}
 
-	diagnostics = setConnectorsList(ctx, data, client, connectors)
+	regenerate := data.HasChanges("connector.0.profile_rotation_trigger", "connector.0.vpn_region_id")
+	diagnostics = setConnectorsList(ctx, data, client, connectors, regenerate)
 	if diagnostics != nil {
 		return diagnostics
 	}
@@ -232,7 +263,7 @@ func updateConnectors(ctx context.Context, data *schema.ResourceData, client *ap
 
 	connector, err := client.UpdateConnector(ctx, connectorData["id"].(string), connectorRequest)
 	if err != nil {
-		return nil, diag.FromErr(err)
+		return nil, diagFromAuthError(err)
 	}
 
 	connectors := []api.Connector{*connector}
@@ -253,42 +284,72 @@ func resourceHostDelete(ctx context.Context, data *schema.ResourceData, i interf
 
 	err := client.DeleteHost(ctx, hostID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAuthError(err)
 	}
 
 	return nil
 }
 
-func setConnectorsList(ctx context.Context, data *schema.ResourceData, client *api.Client, connectors []api.Connector) diag.Diagnostics {
+func setConnectorsList(ctx context.Context, data *schema.ResourceData, client *api.Client, connectors []api.Connector, regenerate bool) diag.Diagnostics {
+	existingConnectors := data.Get("connector").([]interface{})
 	connectorsList := make([]interface{}, len(connectors))
+	var diagnostics diag.Diagnostics
 	for i, connector := range connectors {
-		connectorsData, err := getConnectorsListItem(ctx, client, connector)
-		if err != nil {
-			return diag.FromErr(err)
+		existing := map[string]interface{}{}
+		if i < len(existingConnectors) && existingConnectors[i] != nil {
+			existing = existingConnectors[i].(map[string]interface{})
+		}
+		connectorsData, itemDiagnostics := getConnectorsListItem(ctx, client, connector, existing, regenerate)
+		diagnostics = append(diagnostics, itemDiagnostics...)
+		if diagnostics.HasError() {
+			return diagnostics
 		}
 		connectorsList[i] = connectorsData
 	}
 	err := data.Set("connector", connectorsList)
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diagnostics, diag.FromErr(err)...)
 	}
-	return nil
+	return diagnostics
 }
 
-func getConnectorsListItem(ctx context.Context, client *api.Client, connector api.Connector) (map[string]interface{}, error) {
+func getConnectorsListItem(ctx context.Context, client *api.Client, connector api.Connector, existing map[string]interface{}, regenerate bool) (map[string]interface{}, diag.Diagnostics) {
 	connectorsData := map[string]interface{}{
-		"id":            connector.ID,
-		"name":          connector.Name,
-		"description":   connector.Description,
-		"vpn_region_id": connector.VpnRegionId,
-		"ip_v4_address": connector.IpV4Address,
-		"ip_v6_address": connector.IpV6Address,
+		"id":                       connector.ID,
+		"name":                     connector.Name,
+		"description":              connector.Description,
+		"vpn_region_id":            connector.VpnRegionId,
+		"ip_v4_address":            connector.IpV4Address,
+		"ip_v6_address":            connector.IpV6Address,
+		"profile_rotation_trigger": existing["profile_rotation_trigger"],
 	}
 
-	connectorProfile, err := client.GetConnectorProfile(ctx, connector.ID)
-	if err != nil {
-		return nil, err
+	storeProfile, ok := existing["store_profile"].(bool)
+	if !ok {
+		storeProfile = true
+	}
+	connectorsData["store_profile"] = storeProfile
+
+	// Reuse the known profile unless it has to be rotated, fetching it issues new credentials.
+	connectorProfile, _ := existing["profile"].(string)
+	if !storeProfile {
+		connectorProfile = ""
+	} else if regenerate {
+		var err error
+		connectorProfile, err = client.RegenerateConnectorProfile(ctx, connector.ID)
+		if err != nil {
+			return nil, diagFromAuthError(err)
+		}
+	} else if connectorProfile == "" || existing["id"] != connector.ID {
+		var err error
+		connectorProfile, err = client.GetConnectorProfile(ctx, connector.ID)
+		if err != nil {
+			return nil, diagFromAuthError(err)
+		}
 	}
 	connectorsData["profile"] = connectorProfile
-	return connectorsData, nil
+
+	parsedProfile, diagnostics := parseConnectorProfile(connectorProfile)
+	connectorsData["parsed_profile"] = parsedProfile
+	return connectorsData, diagnostics
 }
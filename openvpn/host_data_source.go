@@ -71,7 +71,7 @@ func dataSourceHostRead(ctx context.Context, data *schema.ResourceData, i interf
 	}
 	host, err := client.GetHost(ctx, hostID)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAuthError(err)
 	}
 
 	data.SetId(host.ID)
@@ -0,0 +1,99 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// resourceHostV0 is the openvpn_host schema before connector profiles could be rotated or kept out of the state.
+func resourceHostV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"internet_access": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"domain": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"system_subnets": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"connector": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"ip_v4_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_v6_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"vpn_region_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"profile": {
+							Type:      schema.TypeString,
+							Computed:  true,
+							Sensitive: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceHostStateUpgradeV0(ctx context.Context, rawState map[string]interface{}, meta interface{}) (map[string]interface{}, error) {
+	if rawState == nil {
+		return rawState, nil
+	}
+
+	connectors, _ := rawState["connector"].([]interface{})
+	for i, connectorI := range connectors {
+		connectorState, ok := connectorI.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		upgradedConnector, err := upgradeConnectorStateV0(connectorState)
+		if err != nil {
+			return nil, err
+		}
+		connectors[i] = upgradedConnector
+	}
+
+	return rawState, nil
+}
@@ -0,0 +1,44 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestResourceHostStateUpgradeV0(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id": "host-id",
+		"connector": []interface{}{
+			map[string]interface{}{
+				"id":      "connector-id",
+				"profile": "",
+			},
+		},
+	}
+
+	upgradedState, err := resourceHostStateUpgradeV0(context.Background(), rawState, nil)
+
+	assert.NoError(t, err)
+	connectorState := upgradedState["connector"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, true, connectorState["store_profile"])
+	assert.Equal(t, []interface{}{}, connectorState["parsed_profile"])
+}
+
+func TestResourceHostStateUpgradeV0_unparsableProfile(t *testing.T) {
+	rawState := map[string]interface{}{
+		"id": "host-id",
+		"connector": []interface{}{
+			map[string]interface{}{
+				"id":      "connector-id",
+				"profile": "<ca>\n",
+			},
+		},
+	}
+
+	upgradedState, err := resourceHostStateUpgradeV0(context.Background(), rawState, nil)
+
+	assert.NoError(t, err)
+	connectorState := upgradedState["connector"].([]interface{})[0].(map[string]interface{})
+	assert.Equal(t, []interface{}{}, connectorState["parsed_profile"])
+}
@@ -143,7 +143,7 @@ func testCheckHostValuesAreSet(dataSourceName string, host api.CreateHostRequest
 	return resource.ComposeAggregateTestCheckFunc(
 		resource.TestCheckResourceAttr(dataSourceName, "name", host.Name),
 		resource.TestCheckResourceAttr(dataSourceName, "description", host.Description),
-		resource.TestCheckResourceAttr(dataSourceName, "internet_access", host.InternetAccess),
+		resource.TestCheckResourceAttr(dataSourceName, "internet_access", string(host.InternetAccess)),
 		resource.TestCheckResourceAttr(dataSourceName, "domain", host.Domain),
 	)
 }
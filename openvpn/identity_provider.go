@@ -0,0 +1,216 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+const identityProviderID = "identity-provider"
+
+// resourceIdentityProvider manages the SAML identity provider of the tenant, destroying it removes the provider.
+func resourceIdentityProvider() *schema.Resource {
+	return &schema.Resource{
+		Description:   "SAML identity provider users sign in with. Destroying the resource removes the identity provider.",
+		CreateContext: resourceIdentityProviderUpdate,
+		ReadContext:   resourceIdentityProviderRead,
+		UpdateContext: resourceIdentityProviderUpdate,
+		DeleteContext: resourceIdentityProviderDelete,
+		CustomizeDiff: customizeDiffIdentityProvider,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"metadata_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"metadata_url", "metadata_xml"},
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+			"metadata_xml": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"metadata_url", "metadata_xml"},
+			},
+			"sp_entity_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"idp_entity_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"attribute_mapping": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"username": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"first_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"last_name": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+						"groups": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"group_auto_provisioning": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceIdentityProviderUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	identityProvider, err := client.UpdateIdentityProvider(ctx, makeIdentityProviderRequest(data))
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setIdentityProviderData(data, identityProvider)
+}
+
+func resourceIdentityProviderRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	identityProvider, err := client.GetIdentityProvider(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setIdentityProviderData(data, identityProvider)
+}
+
+func resourceIdentityProviderDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	err := client.ResetIdentityProvider(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return nil
+}
+
+// customizeDiffIdentityProvider checks at plan time that groups are only provisioned when the attribute holding
+// the groups of a user is mapped.
+func customizeDiffIdentityProvider(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if !diff.NewValueKnown("group_auto_provisioning") || !diff.Get("group_auto_provisioning").(bool) ||
+		!diff.NewValueKnown("attribute_mapping.#") {
+		return nil
+	}
+
+	missingBlock := len(diff.Get("attribute_mapping").([]interface{})) == 0
+	missingGroups := diff.NewValueKnown("attribute_mapping.0.groups") && diff.Get("attribute_mapping.0.groups").(string) == ""
+	if missingBlock || missingGroups {
+		return fmt.Errorf("group_auto_provisioning requires attribute_mapping.groups to be set")
+	}
+	return nil
+}
+
+func makeIdentityProviderRequest(data *schema.ResourceData) *api.IdentityProvider {
+	request := &api.IdentityProvider{
+		Enabled:               data.Get("enabled").(bool),
+		MetadataUrl:           data.Get("metadata_url").(string),
+		MetadataXml:           data.Get("metadata_xml").(string),
+		SpEntityId:            data.Get("sp_entity_id").(string),
+		IdpEntityId:           data.Get("idp_entity_id").(string),
+		GroupAutoProvisioning: data.Get("group_auto_provisioning").(bool),
+	}
+
+	if mappings := data.Get("attribute_mapping").([]interface{}); len(mappings) > 0 && mappings[0] != nil {
+		mappingData := mappings[0].(map[string]interface{})
+		request.AttributeMappings = &api.IdentityProviderAttributeMappings{
+			Username:  mappingData["username"].(string),
+			Email:     mappingData["email"].(string),
+			FirstName: mappingData["first_name"].(string),
+			LastName:  mappingData["last_name"].(string),
+			Groups:    mappingData["groups"].(string),
+		}
+	}
+
+	return request
+}
+
+func setIdentityProviderData(data *schema.ResourceData, identityProvider *api.IdentityProvider) diag.Diagnostics {
+	data.SetId(identityProviderID)
+	err := data.Set("enabled", identityProvider.Enabled)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("metadata_url", identityProvider.MetadataUrl)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("metadata_xml", identityProvider.MetadataXml)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("sp_entity_id", identityProvider.SpEntityId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("idp_entity_id", identityProvider.IdpEntityId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	attributeMapping := []interface{}{}
+	if identityProvider.AttributeMappings != nil && *identityProvider.AttributeMappings != (api.IdentityProviderAttributeMappings{}) {
+		attributeMapping = append(attributeMapping, map[string]interface{}{
+			"username":   identityProvider.AttributeMappings.Username,
+			"email":      identityProvider.AttributeMappings.Email,
+			"first_name": identityProvider.AttributeMappings.FirstName,
+			"last_name":  identityProvider.AttributeMappings.LastName,
+			"groups":     identityProvider.AttributeMappings.Groups,
+		})
+	}
+	err = data.Set("attribute_mapping", attributeMapping)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("group_auto_provisioning", identityProvider.GroupAutoProvisioning)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+func TestResourceIdentityProvider_basic(t *testing.T) {
+	resourceName := "openvpn_identity_provider.test"
+	client := getAuthenticatedClient(t)
+	metadataURL := getTestIdentityProviderMetadataURL(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		CheckDestroy: func(s *terraform.State) error {
+			identityProvider, err := client.GetIdentityProvider(context.Background())
+			if err != nil {
+				return err
+			}
+			if identityProvider.MetadataUrl == metadataURL {
+				return fmt.Errorf("identity provider was not removed")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: resourceIdentityProviderOutputConfig(metadataURL),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", identityProviderID),
+					resource.TestCheckResourceAttr(resourceName, "metadata_url", metadataURL),
+					resource.TestCheckResourceAttrSet(resourceName, "sp_entity_id"),
+					resource.TestCheckResourceAttr(resourceName, "attribute_mapping.0.groups", "memberOf"),
+					resource.TestCheckResourceAttr(resourceName, "group_auto_provisioning", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceIdentityProvider_metadata(t *testing.T) {
+	tests := map[string]struct {
+		config map[string]interface{}
+		valid  bool
+	}{
+		"url":  {map[string]interface{}{"metadata_url": "https://idp.example.com/metadata"}, true},
+		"xml":  {map[string]interface{}{"metadata_xml": "<EntityDescriptor/>"}, true},
+		"both": {map[string]interface{}{"metadata_url": "https://idp.example.com/metadata", "metadata_xml": "<EntityDescriptor/>"}, false},
+		"none": {map[string]interface{}{}, false},
+		"http": {map[string]interface{}{"metadata_url": "http://idp.example.com/metadata"}, false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			diagnostics := resourceIdentityProvider().Validate(terraform.NewResourceConfigRaw(test.config))
+			assert.Equal(t, test.valid, !diagnostics.HasError(), diagnostics)
+		})
+	}
+}
+
+func TestMakeIdentityProviderRequest(t *testing.T) {
+	t.Run("attribute mapping", func(t *testing.T) {
+		// given
+		data := schema.TestResourceDataRaw(t, resourceIdentityProvider().Schema, map[string]interface{}{
+			"metadata_url": "https://idp.example.com/metadata",
+			"attribute_mapping": []interface{}{
+				map[string]interface{}{"username": "uid", "email": "mail", "groups": "memberOf"},
+			},
+			"group_auto_provisioning": true,
+		})
+
+		// when
+		request := makeIdentityProviderRequest(data)
+
+		// then
+		assert.Equal(t, &api.IdentityProvider{
+			Enabled:               true,
+			MetadataUrl:           "https://idp.example.com/metadata",
+			AttributeMappings:     &api.IdentityProviderAttributeMappings{Username: "uid", Email: "mail", Groups: "memberOf"},
+			GroupAutoProvisioning: true,
+		}, request)
+
+		require.Nil(t, setIdentityProviderData(data, request))
+		assert.Equal(t, identityProviderID, data.Id())
+		assert.Equal(t, "memberOf", data.Get("attribute_mapping.0.groups"))
+	})
+
+	t.Run("empty attribute mapping", func(t *testing.T) {
+		data := schema.TestResourceDataRaw(t, resourceIdentityProvider().Schema, map[string]interface{}{})
+
+		require.Nil(t, setIdentityProviderData(data, &api.IdentityProvider{AttributeMappings: &api.IdentityProviderAttributeMappings{}}))
+		assert.Empty(t, data.Get("attribute_mapping"))
+	})
+}
+
+func TestCustomizeDiffIdentityProvider(t *testing.T) {
+	// a provider mapping groups, so removing the block from the config is checked too
+	identityProviderState := &terraform.InstanceState{
+		ID: identityProviderID,
+		Attributes: map[string]string{
+			"id":                         identityProviderID,
+			"enabled":                    "true",
+			"metadata_xml":               "<EntityDescriptor/>",
+			"attribute_mapping.#":        "1",
+			"attribute_mapping.0.groups": "memberOf",
+			"group_auto_provisioning":    "true",
+		},
+	}
+
+	for name, test := range map[string]struct {
+		config map[string]interface{}
+		err    string
+	}{
+		"group auto provisioning with groups mapping": {
+			config: map[string]interface{}{
+				"metadata_xml":            "<EntityDescriptor/>",
+				"attribute_mapping":       []interface{}{map[string]interface{}{"groups": "memberOf"}},
+				"group_auto_provisioning": true,
+			},
+		},
+		"group auto provisioning without groups mapping": {
+			config: map[string]interface{}{
+				"metadata_xml":            "<EntityDescriptor/>",
+				"attribute_mapping":       []interface{}{map[string]interface{}{"username": "uid"}},
+				"group_auto_provisioning": true,
+			},
+			err: "group_auto_provisioning requires attribute_mapping.groups to be set",
+		},
+		"group auto provisioning without attribute mapping": {
+			config: map[string]interface{}{
+				"metadata_xml":            "<EntityDescriptor/>",
+				"group_auto_provisioning": true,
+			},
+			err: "group_auto_provisioning requires attribute_mapping.groups to be set",
+		},
+		"unknown groups mapping": {
+			config: map[string]interface{}{
+				"metadata_xml":            "<EntityDescriptor/>",
+				"attribute_mapping":       []interface{}{map[string]interface{}{"groups": unknownValue}},
+				"group_auto_provisioning": true,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// when
+			_, err := resourceIdentityProvider().Diff(context.Background(), nil, terraform.NewResourceConfigRaw(test.config), nil)
+			_, updateErr := resourceIdentityProvider().Diff(context.Background(), identityProviderState, terraform.NewResourceConfigRaw(test.config), nil)
+
+			// then
+			if test.err == "" {
+				assert.NoError(t, err)
+				assert.NoError(t, updateErr)
+			} else {
+				assert.EqualError(t, err, test.err)
+				assert.EqualError(t, updateErr, test.err)
+			}
+		})
+	}
+}
+
+func resourceIdentityProviderOutputConfig(metadataURL string) string {
+	return fmt.Sprintf(`
+provider "openvpn" {}
+
+resource "openvpn_identity_provider" "test" {
+	metadata_url = "%s"
+
+	attribute_mapping {
+		username = "uid"
+		email = "mail"
+		groups = "memberOf"
+	}
+
+	group_auto_provisioning = true
+}
+`, metadataURL)
+}
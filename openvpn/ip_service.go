@@ -0,0 +1,176 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+func resourceIPService() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIPServiceCreate,
+		ReadContext:   resourceIPServiceRead,
+		UpdateContext: resourceIPServiceUpdate,
+		DeleteContext: resourceIPServiceDelete,
+		CustomizeDiff: customizeDiffServiceConfig,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+					value := api.IPServiceType(i.(string))
+					return diag.FromErr(value.Validate())
+				},
+			},
+			"network_item_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"network_item_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+					value := api.NetworkItemType(i.(string))
+					return diag.FromErr(value.Validate())
+				},
+			},
+			"routes": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.Any(validation.IsCIDR, validation.IsIPAddress),
+				},
+			},
+			"config": serviceConfigSchema(),
+		},
+	}
+}
+
+func resourceIPServiceCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	service, err := client.CreateIPService(ctx, makeIPServiceRequest(data))
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setIPServiceData(data, service)
+}
+
+func resourceIPServiceRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	service, err := client.GetIPService(ctx, data.Id())
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setIPServiceData(data, service)
+}
+
+func resourceIPServiceUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	service, err := client.UpdateIPService(ctx, data.Id(), makeIPServiceRequest(data))
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setIPServiceData(data, service)
+}
+
+func resourceIPServiceDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	err := client.DeleteIPService(ctx, data.Id())
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return nil
+}
+
+func makeIPServiceRequest(data *schema.ResourceData) *api.IPServiceData {
+	routesI := data.Get("routes").([]interface{})
+	routes := make([]api.IPServiceRoute, len(routesI))
+	for i, route := range routesI {
+		routes[i] = api.IPServiceRoute{Value: route.(string)}
+	}
+
+	return &api.IPServiceData{
+		Name:            data.Get("name").(string),
+		Description:     data.Get("description").(string),
+		NetworkItemId:   data.Get("network_item_id").(string),
+		NetworkItemType: api.NetworkItemType(data.Get("network_item_type").(string)),
+		Type:            api.IPServiceType(data.Get("type").(string)),
+		Routes:          routes,
+		Config:          expandServiceConfig(data.Get("config").([]interface{})),
+	}
+}
+
+func setIPServiceData(data *schema.ResourceData, service *api.IPService) diag.Diagnostics {
+	data.SetId(service.ID)
+	err := data.Set("name", service.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("description", service.Description)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("type", service.Type)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("network_item_id", service.NetworkItemId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("network_item_type", service.NetworkItemType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routes := make([]string, len(service.Routes))
+	for i, route := range service.Routes {
+		routes[i] = route.Value
+	}
+	err = data.Set("routes", routes)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("config", flattenServiceConfig(service.Config))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+func TestResourceIPService_basic(t *testing.T) {
+	resourceName := "openvpn_ip_service.test"
+	serviceName := "svc-" + RandomString(7)
+
+	client := getAuthenticatedClient(t)
+
+	host := createTestHost(t, client, getDefaultRegionID(t, client))
+	t.Cleanup(func() {
+		deleteTestHost(t, client, host.ID)
+	})
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		CheckDestroy:      testAccCheckIPServiceDestroy(client),
+		Steps: []resource.TestStep{
+			{
+				Config: resourceIPServiceOutputConfig("test", serviceName, host.ID, "22", "22"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", serviceName),
+					resource.TestCheckResourceAttr(resourceName, "type", string(api.IPServiceTypeServiceDestination)),
+					resource.TestCheckResourceAttr(resourceName, "network_item_id", host.ID),
+					resource.TestCheckResourceAttr(resourceName, "routes.0", "10.10.0.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.custom_service_types.0.port.0.from", "22"),
+				),
+			},
+			{
+				Config: resourceIPServiceOutputConfig("test", serviceName, host.ID, "8000", "8080"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "config.0.custom_service_types.0.port.0.from", "8000"),
+					resource.TestCheckResourceAttr(resourceName, "config.0.custom_service_types.0.port.0.to", "8080"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIPServiceDestroy(client *api.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openvpn_ip_service" {
+				continue
+			}
+
+			_, err := client.GetIPService(context.Background(), rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("ip service %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func resourceIPServiceOutputConfig(name, serviceName, hostID, fromPort, toPort string) string {
+	return fmt.Sprintf(`
+provider "openvpn" {}
+
+resource "openvpn_ip_service" "%s" {
+	name = "%s"
+	type = "SERVICE_DESTINATION"
+	network_item_id = "%s"
+	network_item_type = "HOST"
+	routes = ["10.10.0.0/24"]
+
+	config {
+		custom_service_types {
+			protocol = "TCP"
+			port {
+				from = %s
+				to = %s
+			}
+		}
+	}
+}
+`, name, serviceName, hostID, fromPort, toPort)
+}
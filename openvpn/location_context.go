@@ -0,0 +1,264 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+func resourceLocationContext() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLocationContextCreate,
+		ReadContext:   resourceLocationContextRead,
+		UpdateContext: resourceLocationContextUpdate,
+		DeleteContext: resourceLocationContextDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"user_group_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ip_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"ip": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"ip": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsCIDR,
+									},
+									"description": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"country_policy": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+						"countries": {
+							Type:     schema.TypeSet,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: validateCountryISO,
+							},
+						},
+					},
+				},
+			},
+			"default_policy": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed": {
+							Type:     schema.TypeBool,
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceLocationContextCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	locationContext, err := client.CreateLocationContext(ctx, makeLocationContextRequest(data))
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setLocationContextData(data, locationContext)
+}
+
+func resourceLocationContextRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	locationContext, err := client.GetLocationContext(ctx, data.Id())
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setLocationContextData(data, locationContext)
+}
+
+func resourceLocationContextUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	locationContext, err := client.UpdateLocationContext(ctx, data.Id(), makeLocationContextRequest(data))
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setLocationContextData(data, locationContext)
+}
+
+func resourceLocationContextDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	err := client.DeleteLocationContext(ctx, data.Id())
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return nil
+}
+
+func makeLocationContextRequest(data *schema.ResourceData) *api.LocationContext {
+	request := &api.LocationContext{
+		Name:          data.Get("name").(string),
+		Description:   data.Get("description").(string),
+		UserGroupsIds: expandStringSet(data.Get("user_group_ids").(*schema.Set)),
+		DefaultPolicy: &api.DefaultPolicy{
+			Allowed: data.Get("default_policy.0.allowed").(bool),
+		},
+	}
+
+	if ipPolicies := data.Get("ip_policy").([]interface{}); len(ipPolicies) > 0 {
+		ipPolicyData := ipPolicies[0].(map[string]interface{})
+		ipsI := ipPolicyData["ip"].([]interface{})
+		request.IpPolicy = &api.IpPolicy{
+			Allowed: ipPolicyData["allowed"].(bool),
+			Ips:     make([]api.IpPolicyIp, len(ipsI)),
+		}
+		for i, ipI := range ipsI {
+			ipData := ipI.(map[string]interface{})
+			request.IpPolicy.Ips[i] = api.IpPolicyIp{
+				Ip:          ipData["ip"].(string),
+				Description: ipData["description"].(string),
+			}
+		}
+	}
+
+	if countryPolicies := data.Get("country_policy").([]interface{}); len(countryPolicies) > 0 {
+		countryPolicyData := countryPolicies[0].(map[string]interface{})
+		request.CountryPolicy = &api.CountryPolicy{
+			Allowed:   countryPolicyData["allowed"].(bool),
+			Countries: expandStringSet(countryPolicyData["countries"].(*schema.Set)),
+		}
+	}
+
+	return request
+}
+
+func setLocationContextData(data *schema.ResourceData, locationContext *api.LocationContext) diag.Diagnostics {
+	data.SetId(locationContext.ID)
+	err := data.Set("name", locationContext.Name)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("description", locationContext.Description)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("user_group_ids", locationContext.UserGroupsIds)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ipPolicy := []interface{}{}
+	if locationContext.IpPolicy != nil {
+		ips := make([]interface{}, len(locationContext.IpPolicy.Ips))
+		for i, ip := range locationContext.IpPolicy.Ips {
+			ips[i] = map[string]interface{}{
+				"ip":          ip.Ip,
+				"description": ip.Description,
+			}
+		}
+		ipPolicy = append(ipPolicy, map[string]interface{}{
+			"allowed": locationContext.IpPolicy.Allowed,
+			"ip":      ips,
+		})
+	}
+	err = data.Set("ip_policy", ipPolicy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	countryPolicy := []interface{}{}
+	if locationContext.CountryPolicy != nil {
+		countryPolicy = append(countryPolicy, map[string]interface{}{
+			"allowed":   locationContext.CountryPolicy.Allowed,
+			"countries": locationContext.CountryPolicy.Countries,
+		})
+	}
+	err = data.Set("country_policy", countryPolicy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	defaultPolicy := []interface{}{}
+	if locationContext.DefaultPolicy != nil {
+		defaultPolicy = append(defaultPolicy, map[string]interface{}{
+			"allowed": locationContext.DefaultPolicy.Allowed,
+		})
+	}
+	err = data.Set("default_policy", defaultPolicy)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func expandStringSet(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, value := range set.List() {
+		values = append(values, value.(string))
+	}
+	return values
+}
@@ -0,0 +1,136 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+func TestResourceLocationContext_invalidCountry(t *testing.T) {
+	// given
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":           "lc",
+		"user_group_ids": []interface{}{"group-id"},
+		"country_policy": []interface{}{
+			map[string]interface{}{"allowed": true, "countries": []interface{}{"DE", "XX"}},
+		},
+		"default_policy": []interface{}{map[string]interface{}{"allowed": false}},
+	})
+
+	// when
+	diagnostics := resourceLocationContext().Validate(config)
+
+	// then
+	require.Len(t, diagnostics, 1)
+	assert.Contains(t, diagnostics[0].Summary, "invalid country 'XX'")
+}
+
+func TestResourceLocationContext_basic(t *testing.T) {
+	resourceName := "openvpn_location_context.test"
+	locationContextName := "lc-" + RandomString(7)
+
+	client := getAuthenticatedClient(t)
+	groupID := getTestUserGroupID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		CheckDestroy:      testAccCheckLocationContextDestroy(client),
+		Steps: []resource.TestStep{
+			{
+				Config: resourceLocationContextOutputConfig("test", locationContextName, groupID, "DE"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "name", locationContextName),
+					resource.TestCheckResourceAttr(resourceName, "ip_policy.0.ip.0.ip", "192.0.2.0/24"),
+					resource.TestCheckResourceAttr(resourceName, "country_policy.0.countries.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "default_policy.0.allowed", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestMakeLocationContextRequest(t *testing.T) {
+	// given
+	data := schema.TestResourceDataRaw(t, resourceLocationContext().Schema, map[string]interface{}{
+		"name":           "office",
+		"user_group_ids": []interface{}{"group-id"},
+		"ip_policy": []interface{}{
+			map[string]interface{}{
+				"allowed": true,
+				"ip":      []interface{}{map[string]interface{}{"ip": "192.0.2.0/24", "description": "office"}},
+			},
+		},
+		"default_policy": []interface{}{map[string]interface{}{"allowed": false}},
+	})
+
+	// when
+	request := makeLocationContextRequest(data)
+
+	// then
+	assert.Equal(t, &api.LocationContext{
+		Name:          "office",
+		UserGroupsIds: []string{"group-id"},
+		IpPolicy:      &api.IpPolicy{Allowed: true, Ips: []api.IpPolicyIp{{Ip: "192.0.2.0/24", Description: "office"}}},
+		DefaultPolicy: &api.DefaultPolicy{Allowed: false},
+	}, request)
+
+	request.ID = "location-context-id"
+	require.Nil(t, setLocationContextData(data, request))
+	assert.Empty(t, data.Get("country_policy"))
+	assert.Equal(t, "office", data.Get("ip_policy.0.ip.0.description"))
+}
+
+func testAccCheckLocationContextDestroy(client *api.Client) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openvpn_location_context" {
+				continue
+			}
+
+			_, err := client.GetLocationContext(context.Background(), rs.Primary.ID)
+			if err == nil {
+				return fmt.Errorf("location context %s still exists", rs.Primary.ID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func resourceLocationContextOutputConfig(name, locationContextName, groupID, country string) string {
+	return fmt.Sprintf(`
+provider "openvpn" {}
+
+resource "openvpn_location_context" "%s" {
+	name = "%s"
+	user_group_ids = ["%s"]
+
+	ip_policy {
+		allowed = true
+		ip {
+			ip = "192.0.2.0/24"
+			description = "office"
+		}
+	}
+
+	country_policy {
+		allowed = true
+		countries = ["%s"]
+	}
+
+	default_policy {
+		allowed = false
+	}
+}
+`, name, locationContextName, groupID, country)
+}
@@ -0,0 +1,223 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"math"
+	"sort"
+	"strings"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+const earthRadiusKm = 6371.0
+
+// regionLocations are the approximate data center locations of the known regions by id. Regions missing here
+// are located at the center of their country.
+var regionLocations = map[string]country{
+	"us-east-1":      {"North America", 39.04, -77.49},
+	"us-east-2":      {"North America", 39.96, -83.0},
+	"us-west-1":      {"North America", 37.34, -121.89},
+	"us-west-2":      {"North America", 45.84, -119.7},
+	"ca-central-1":   {"North America", 45.5, -73.57},
+	"sa-east-1":      {"South America", -23.55, -46.63},
+	"eu-central-1":   {"Europe", 50.11, 8.68},
+	"eu-west-1":      {"Europe", 53.35, -6.26},
+	"eu-west-2":      {"Europe", 51.51, -0.13},
+	"eu-west-3":      {"Europe", 48.86, 2.35},
+	"eu-north-1":     {"Europe", 59.33, 18.07},
+	"eu-south-1":     {"Europe", 45.46, 9.19},
+	"af-south-1":     {"Africa", -33.92, 18.42},
+	"me-south-1":     {"Asia", 26.07, 50.56},
+	"ap-south-1":     {"Asia", 19.08, 72.88},
+	"ap-southeast-1": {"Asia", 1.35, 103.82},
+	"ap-southeast-2": {"Oceania", -33.87, 151.21},
+	"ap-northeast-1": {"Asia", 35.68, 139.69},
+	"ap-northeast-2": {"Asia", 37.57, 126.98},
+	"ap-northeast-3": {"Asia", 34.69, 135.5},
+	"ap-east-1":      {"Asia", 22.32, 114.17},
+}
+
+func dataSourceNearestRegion() *schema.Resource {
+	return &schema.Resource{
+		Description: "The region closest to a country or to coordinates. Known regions are located at their data center, " +
+			"other regions at the center of their country, so between two of those in the same country the one with the lower id is returned.",
+		ReadContext: dataNearestRegionRead,
+		Schema: map[string]*schema.Schema{
+			"country_iso": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ExactlyOneOf:  []string{"country_iso", "latitude"},
+				ConflictsWith: []string{"latitude", "longitude"},
+			},
+			"latitude": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"longitude"},
+				ValidateFunc: validation.FloatBetween(-90, 90),
+			},
+			"longitude": {
+				Type:         schema.TypeFloat,
+				Optional:     true,
+				RequiredWith: []string{"latitude"},
+				ValidateFunc: validation.FloatBetween(-180, 180),
+			},
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"continent": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region_country_iso": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"region_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"distance_km": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataNearestRegionRead(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	var origin country
+	if countryISO, ok := d.GetOk("country_iso"); ok {
+		origin, ok = countries[strings.ToUpper(countryISO.(string))]
+		if !ok {
+			return diag.Errorf("unknown country_iso '%s'", countryISO)
+		}
+	} else {
+		origin = country{
+			Latitude:  d.Get("latitude").(float64),
+			Longitude: d.Get("longitude").(float64),
+		}
+		origin.Continent = nearestCountry(origin).Continent
+	}
+
+	regions, err := client.ListRegions(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	region, distance, err := nearestRegion(regions, origin)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(region.ID)
+	err = d.Set("continent", region.Continent)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("country", region.Country)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("region_country_iso", region.CountryISO)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("region_name", region.RegionName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if distance >= 0 {
+		err = d.Set("distance_km", math.Round(distance))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+// nearestRegion returns the region located closest to the origin along with its distance in kilometers,
+// ties go to the lowest region id. When none of the regions has a known location, the first region on
+// the origin's continent is returned with a negative distance.
+func nearestRegion(regions []api.Region, origin country) (api.Region, float64, error) {
+	sorted := make([]api.Region, len(regions))
+	copy(sorted, regions)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].ID < sorted[j].ID
+	})
+
+	bestDistance := -1.0
+	var best api.Region
+	for _, region := range sorted {
+		location, ok := regionLocation(region)
+		if !ok {
+			continue
+		}
+		distance := haversineDistance(origin, location)
+		if bestDistance < 0 || distance < bestDistance {
+			best, bestDistance = region, distance
+		}
+	}
+	if bestDistance >= 0 {
+		return best, bestDistance, nil
+	}
+
+	for _, region := range sorted {
+		if normalizeContinent(region.Continent) == normalizeContinent(origin.Continent) {
+			return region, -1, nil
+		}
+	}
+
+	return api.Region{}, -1, fmt.Errorf("no region found near the given location")
+}
+
+func regionLocation(region api.Region) (country, bool) {
+	if location, ok := regionLocations[region.ID]; ok {
+		return location, true
+	}
+	location, ok := countries[strings.ToUpper(region.CountryISO)]
+	return location, ok
+}
+
+func nearestCountry(location country) country {
+	var nearest country
+	nearestDistance := -1.0
+	for _, c := range countries {
+		distance := haversineDistance(location, c)
+		if nearestDistance < 0 || distance < nearestDistance {
+			nearest, nearestDistance = c, distance
+		}
+	}
+	return nearest
+}
+
+func haversineDistance(from, to country) float64 {
+	toRadians := func(degrees float64) float64 {
+		return degrees * math.Pi / 180
+	}
+	deltaLatitude := toRadians(to.Latitude - from.Latitude)
+	deltaLongitude := toRadians(to.Longitude - from.Longitude)
+
+	a := math.Sin(deltaLatitude/2)*math.Sin(deltaLatitude/2) +
+		math.Cos(toRadians(from.Latitude))*math.Cos(toRadians(to.Latitude))*
+			math.Sin(deltaLongitude/2)*math.Sin(deltaLongitude/2)
+	return 2 * earthRadiusKm * math.Atan2(math.Sqrt(a), math.Sqrt(1-a))
+}
+
+// normalizeContinent makes continent names like "NORTH_AMERICA" and "North America" comparable.
+func normalizeContinent(continent string) string {
+	return strings.ToLower(strings.NewReplacer("_", " ", "-", " ").Replace(continent))
+}
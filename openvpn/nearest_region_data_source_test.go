@@ -0,0 +1,105 @@
+package openvpn
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+const dataNearestRegionOutputConfig = `
+provider "openvpn" {
+}
+
+data "openvpn_nearest_region" "test" {
+	country_iso = "DE"
+}
+`
+
+func TestDataNearestRegion(t *testing.T) {
+	dataSourceName := "data.openvpn_nearest_region.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: dataNearestRegionOutputConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "region_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "distance_km"),
+				),
+			},
+		},
+	})
+}
+
+func TestNearestRegion(t *testing.T) {
+	t.Run("by country", func(t *testing.T) {
+		region, distance, err := nearestRegion(testRegions, countries["FR"])
+
+		assert.NoError(t, err)
+		assert.Equal(t, "eu-central-1", region.ID)
+		assert.InDelta(t, 645, distance, 10)
+	})
+
+	t.Run("by coordinates", func(t *testing.T) {
+		// Kuala Lumpur
+		region, _, err := nearestRegion(testRegions, country{Latitude: 3.14, Longitude: 101.69})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ap-southeast-1", region.ID)
+	})
+
+	t.Run("same country", func(t *testing.T) {
+		// Seattle
+		region, _, err := nearestRegion(testRegions, country{Latitude: 47.61, Longitude: -122.33})
+		assert.NoError(t, err)
+		assert.Equal(t, "us-west-1", region.ID)
+
+		// New York
+		region, _, err = nearestRegion(testRegions, country{Latitude: 40.71, Longitude: -74.01})
+		assert.NoError(t, err)
+		assert.Equal(t, "us-east-1", region.ID)
+	})
+
+	t.Run("unknown regions of the same country are sorted by id", func(t *testing.T) {
+		regions := []api.Region{
+			{ID: "us-2", Continent: "North America", CountryISO: "US"},
+			{ID: "us-1", Continent: "North America", CountryISO: "US"},
+		}
+
+		region, distance, err := nearestRegion(regions, country{Latitude: 47.61, Longitude: -122.33})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "us-1", region.ID)
+		assert.Greater(t, distance, 0.0)
+	})
+
+	t.Run("continent fallback", func(t *testing.T) {
+		regions := []api.Region{
+			{ID: "eu-1", Continent: "EUROPE", CountryISO: "??"},
+			{ID: "na-1", Continent: "NORTH_AMERICA", CountryISO: "??"},
+		}
+
+		region, distance, err := nearestRegion(regions, countries["CA"])
+
+		assert.NoError(t, err)
+		assert.Equal(t, "na-1", region.ID)
+		assert.Equal(t, -1.0, distance)
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		_, _, err := nearestRegion([]api.Region{{ID: "eu-1", Continent: "Europe"}}, countries["AU"])
+
+		assert.Error(t, err)
+	})
+}
+
+func TestNearestCountry(t *testing.T) {
+	// Berlin
+	assert.Equal(t, "Europe", nearestCountry(country{Latitude: 52.52, Longitude: 13.4}).Continent)
+}
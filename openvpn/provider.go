@@ -2,10 +2,15 @@ package openvpn
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"net/http"
+	"net/url"
+	"os"
 	"terraform-provider-openvpn/openvpn/api"
 )
 
@@ -33,29 +38,58 @@ func Provider() *schema.Provider {
 			"host": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				DefaultFunc:  schema.EnvDefaultFunc("OVPN_HOST", nil),
 				ValidateFunc: validation.IsURLWithHTTPS,
 			},
 			"client_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"client_secret": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"access_token": {
+				Type:      schema.TypeString,
+				Optional:  true,
+				Sensitive: true,
+			},
+			"profile": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("OVPN_CLIENT_ID", nil),
+				DefaultFunc: schema.EnvDefaultFunc("OVPN_PROFILE", nil),
 			},
-			"client_secret": {
+			"credentials_file": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Sensitive:   true,
-				DefaultFunc: schema.EnvDefaultFunc("OVPN_CLIENT_SECRET", nil),
+				DefaultFunc: schema.EnvDefaultFunc("OVPN_CREDENTIALS_FILE", nil),
 			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"openvpn_host":      resourceHost(),
-			"openvpn_connector": resourceConnector(),
+			"openvpn_host":                           resourceHost(),
+			"openvpn_connector":                      resourceConnector(),
+			"openvpn_connector_profile":              resourceConnectorProfile(),
+			"openvpn_ip_service":                     resourceIPService(),
+			"openvpn_application":                    resourceApplication(),
+			"openvpn_access_group":                   resourceAccessGroup(),
+			"openvpn_location_context":               resourceLocationContext(),
+			"openvpn_device_posture":                 resourceDevicePosture(),
+			"openvpn_settings_dns":                   resourceSettingsDns(),
+			"openvpn_settings_default_region":        resourceSettingsDefaultRegion(),
+			"openvpn_settings_auto_connect":          resourceSettingsAutoConnect(),
+			"openvpn_settings_domain_routing_subnet": resourceSettingsDomainRoutingSubnet(),
+			"openvpn_user_group_membership":          resourceUserGroupMembership(),
+			"openvpn_device":                         resourceDevice(),
+			"openvpn_identity_provider":              resourceIdentityProvider(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"openvpn_regions":   dataSourceRegions(),
-			"openvpn_host":      dataSourceHost(),
-			"openvpn_connector": dataSourceConnector(),
+			"openvpn_region":         dataSourceRegion(),
+			"openvpn_regions":        dataSourceRegions(),
+			"openvpn_nearest_region": dataSourceNearestRegion(),
+			"openvpn_host":           dataSourceHost(),
+			"openvpn_connector":      dataSourceConnector(),
+			"openvpn_user_devices":   dataSourceUserDevices(),
+			"openvpn_user_profile":   dataSourceUserProfile(),
 		},
 		ConfigureContextFunc: configureProviderContext,
 	}
@@ -66,21 +100,199 @@ func configureProviderContext(ctx context.Context, data *schema.ResourceData) (i
 		Host:         data.Get("host").(string),
 		ClientID:     data.Get("client_id").(string),
 		ClientSecret: data.Get("client_secret").(string),
+		AccessToken:  data.Get("access_token").(string),
+	}
+
+	diagnostics := applyCredentialsProfile(data, authConfig)
+	if diagnostics.HasError() {
+		return nil, diagnostics
+	}
+
+	diagnostics = append(diagnostics, validateAuthConfig(authConfig)...)
+	if diagnostics.HasError() {
+		return nil, diagnostics
 	}
 
 	httpClient := &http.Client{}
 
-	client := api.NewClient(httpClient, authConfig)
-	err := client.Authenticate(ctx)
+	return api.NewClient(httpClient, authConfig), diagnostics
+}
+
+func validateAuthConfig(authConfig *api.AuthConfig) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	configured := authConfig.ClientID != "" || authConfig.ClientSecret != "" || authConfig.AccessToken != ""
+
+	if authConfig.Host == "" {
+		if configured {
+			diagnostics = append(diagnostics, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "missing host",
+				Detail:        "set the host argument, the OVPN_HOST environment variable or host in the credentials profile",
+				AttributePath: cty.GetAttrPath("host"),
+			})
+		}
+	} else if hostUrl, err := url.Parse(authConfig.Host); err != nil || hostUrl.Scheme != "https" || hostUrl.Host == "" {
+		diagnostics = append(diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "malformed host",
+			Detail:        fmt.Sprintf("host '%s' must be an https URL, e.g. https://example.api.openvpn.com", authConfig.Host),
+			AttributePath: cty.GetAttrPath("host"),
+		})
+	}
+
+	// Credentials which are not configured at all are only reported once an API call needs them,
+	// so that plans which don't touch OpenVPN resources keep working without them.
+	if !configured || authConfig.AccessToken != "" {
+		return diagnostics
+	}
+
+	if authConfig.ClientID == "" {
+		diagnostics = append(diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "missing client_id",
+			Detail:        "set the client_id argument, the OVPN_CLIENT_ID environment variable or client_id in the credentials profile",
+			AttributePath: cty.GetAttrPath("client_id"),
+		})
+	}
+	if authConfig.ClientSecret == "" {
+		diagnostics = append(diagnostics, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "missing client_secret",
+			Detail:        "set the client_secret argument, the OVPN_CLIENT_SECRET environment variable or client_secret in the credentials profile",
+			AttributePath: cty.GetAttrPath("client_secret"),
+		})
+	}
+
+	return diagnostics
+}
+
+// diagFromAuthError converts an error of an API call to diagnostics. Authentication happens on the first
+// API call, so authentication errors reach the resources and are reported on the provider attribute causing them.
+func diagFromAuthError(err error) diag.Diagnostics {
+	if errors.Is(err, api.ErrMissingCredentials) {
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       "missing credentials",
+				Detail:        err.Error(),
+				AttributePath: cty.GetAttrPath("client_id"),
+			},
+		}
+	}
+
+	if errors.Is(err, api.ErrInvalidCredentials) {
+		detail := "the API rejected client_id and client_secret: " + err.Error()
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       "invalid credentials",
+				Detail:        detail,
+				AttributePath: cty.GetAttrPath("client_id"),
+			},
+			{
+				Severity:      diag.Error,
+				Summary:       "invalid credentials",
+				Detail:        detail,
+				AttributePath: cty.GetAttrPath("client_secret"),
+			},
+		}
+	}
+
+	if errors.Is(err, api.ErrAccessTokenRejected) {
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       "access token rejected",
+				Detail:        "the API rejected access_token, it may have expired: " + err.Error(),
+				AttributePath: cty.GetAttrPath("access_token"),
+			},
+		}
+	}
+
+	// canceled and timed out requests are reported as *url.Error too, but the host is not at fault
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return diag.FromErr(err)
+	}
+
+	var urlErr *url.Error
+	if errors.As(err, &urlErr) {
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       "unable to reach the API",
+				Detail:        err.Error(),
+				AttributePath: cty.GetAttrPath("host"),
+			},
+		}
+	}
+
+	return diag.FromErr(err)
+}
+
+// applyCredentialsProfile completes the explicitly configured attributes. A selected profile provides host and
+// credentials as a whole, so environment variables meant for another tenant are not mixed in. Without a selected
+// profile the environment variables are used and the default profile, if any, fills the remaining values.
+func applyCredentialsProfile(data *schema.ResourceData, authConfig *api.AuthConfig) diag.Diagnostics {
+	profileName := data.Get("profile").(string)
+	explicitProfile := profileName != ""
+	if !explicitProfile {
+		authConfig.ApplyProfile(envCredentialsProfile())
+		profileName = api.DefaultProfile
+	}
+
+	credentialsFile := data.Get("credentials_file").(string)
+	explicitFile := credentialsFile != ""
+	if !explicitFile {
+		defaultPath, err := api.DefaultCredentialsFilePath()
+		if err != nil {
+			if explicitProfile {
+				return diag.FromErr(err)
+			}
+			return nil
+		}
+		credentialsFile = defaultPath
+	}
+
+	profiles, err := api.LoadCredentialsFile(credentialsFile)
 	if err != nil {
-		return nil, diag.Diagnostics{
+		if os.IsNotExist(err) && !explicitProfile && !explicitFile {
+			return nil
+		}
+		return diag.Diagnostics{
+			{
+				Severity:      diag.Error,
+				Summary:       "unable to read credentials file",
+				Detail:        err.Error(),
+				AttributePath: cty.GetAttrPath("credentials_file"),
+			},
+		}
+	}
+
+	profile, ok := profiles[profileName]
+	if !ok {
+		if !explicitProfile {
+			return nil
+		}
+		return diag.Diagnostics{
 			{
-				Severity: diag.Error,
-				Summary:  "authentication failed: " + err.Error(),
-				Detail:   err.Error(),
+				Severity:      diag.Error,
+				Summary:       "credentials profile not found",
+				Detail:        fmt.Sprintf("profile '%s' is not defined in %s", profileName, credentialsFile),
+				AttributePath: cty.GetAttrPath("profile"),
 			},
 		}
 	}
 
-	return client, nil
+	authConfig.ApplyProfile(profile)
+	return nil
+}
+
+func envCredentialsProfile() api.CredentialsProfile {
+	return api.CredentialsProfile{
+		Host:         os.Getenv("OVPN_HOST"),
+		ClientID:     os.Getenv("OVPN_CLIENT_ID"),
+		ClientSecret: os.Getenv("OVPN_CLIENT_SECRET"),
+		AccessToken:  os.Getenv("OVPN_ACCESS_TOKEN"),
+	}
 }
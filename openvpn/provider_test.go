@@ -2,10 +2,19 @@ package openvpn
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"os"
+	"path/filepath"
+	"terraform-provider-openvpn/openvpn/api"
 	"testing"
 )
 
@@ -54,6 +63,210 @@ func TestConfigureProvider(t *testing.T) {
 	}
 }
 
+func TestApplyCredentialsProfile(t *testing.T) {
+	credentialsFile := filepath.Join(t.TempDir(), "credentials")
+	err := os.WriteFile(credentialsFile, []byte(`
+[staging]
+host = https://staging.openvpn.com
+client_id = staging-id
+client_secret = staging-secret
+`), 0600)
+	require.NoError(t, err)
+
+	provider := Provider()
+
+	t.Run("selected profile", func(t *testing.T) {
+		resourceData := schema.TestResourceDataRaw(t, provider.Schema, map[string]interface{}{
+			"profile":          "staging",
+			"credentials_file": credentialsFile,
+		})
+		authConfig := &api.AuthConfig{}
+
+		diagnostics := applyCredentialsProfile(resourceData, authConfig)
+
+		assert.False(t, diagnostics.HasError())
+		assert.Equal(t, &api.AuthConfig{
+			Host:         "https://staging.openvpn.com",
+			ClientID:     "staging-id",
+			ClientSecret: "staging-secret",
+		}, authConfig)
+	})
+
+	t.Run("selected profile ignores environment variables", func(t *testing.T) {
+		t.Setenv("OVPN_HOST", "https://prod.openvpn.com")
+		t.Setenv("OVPN_CLIENT_ID", "prod-id")
+		t.Setenv("OVPN_ACCESS_TOKEN", "prod-token")
+		resourceData := schema.TestResourceDataRaw(t, provider.Schema, map[string]interface{}{
+			"profile":          "staging",
+			"credentials_file": credentialsFile,
+			"client_secret":    "explicit-secret",
+		})
+		authConfig := &api.AuthConfig{ClientSecret: "explicit-secret"}
+
+		diagnostics := applyCredentialsProfile(resourceData, authConfig)
+
+		assert.False(t, diagnostics.HasError())
+		assert.Equal(t, &api.AuthConfig{
+			Host:         "https://staging.openvpn.com",
+			ClientID:     "staging-id",
+			ClientSecret: "explicit-secret",
+		}, authConfig)
+	})
+
+	t.Run("environment variables without selected profile", func(t *testing.T) {
+		t.Setenv("OVPN_HOST", "https://prod.openvpn.com")
+		t.Setenv("OVPN_CLIENT_ID", "prod-id")
+		t.Setenv("OVPN_CLIENT_SECRET", "prod-secret")
+		resourceData := schema.TestResourceDataRaw(t, provider.Schema, map[string]interface{}{
+			"credentials_file": credentialsFile,
+		})
+		authConfig := &api.AuthConfig{}
+
+		diagnostics := applyCredentialsProfile(resourceData, authConfig)
+
+		assert.False(t, diagnostics.HasError())
+		assert.Equal(t, &api.AuthConfig{
+			Host:         "https://prod.openvpn.com",
+			ClientID:     "prod-id",
+			ClientSecret: "prod-secret",
+		}, authConfig)
+	})
+
+	t.Run("unknown profile", func(t *testing.T) {
+		resourceData := schema.TestResourceDataRaw(t, provider.Schema, map[string]interface{}{
+			"profile":          "prod",
+			"credentials_file": credentialsFile,
+		})
+
+		diagnostics := applyCredentialsProfile(resourceData, &api.AuthConfig{})
+
+		assert.True(t, diagnostics.HasError())
+	})
+}
+
+func TestValidateAuthConfig(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		diagnostics := validateAuthConfig(&api.AuthConfig{})
+
+		assert.Empty(t, diagnostics)
+	})
+
+	t.Run("missing values", func(t *testing.T) {
+		diagnostics := validateAuthConfig(&api.AuthConfig{ClientID: "id"})
+
+		assert.Equal(t, []cty.Path{
+			cty.GetAttrPath("host"),
+			cty.GetAttrPath("client_secret"),
+		}, diagnosticPaths(diagnostics))
+	})
+
+	t.Run("malformed host", func(t *testing.T) {
+		diagnostics := validateAuthConfig(&api.AuthConfig{
+			Host:         "openvpn.com/api",
+			ClientID:     "id",
+			ClientSecret: "secret",
+		})
+
+		assert.Equal(t, []cty.Path{cty.GetAttrPath("host")}, diagnosticPaths(diagnostics))
+	})
+
+	t.Run("access token without client credentials", func(t *testing.T) {
+		diagnostics := validateAuthConfig(&api.AuthConfig{
+			Host:        "https://test.openvpn.com",
+			AccessToken: "token",
+		})
+
+		assert.Empty(t, diagnostics)
+	})
+}
+
+func TestDiagFromAuthError(t *testing.T) {
+	t.Run("missing credentials", func(t *testing.T) {
+		diagnostics := diagFromAuthError(fmt.Errorf("authentication failed: %w", api.ErrMissingCredentials))
+
+		assert.Equal(t, []cty.Path{cty.GetAttrPath("client_id")}, diagnosticPaths(diagnostics))
+	})
+
+	t.Run("invalid credentials", func(t *testing.T) {
+		diagnostics := diagFromAuthError(fmt.Errorf("authentication failed: %w: 401 Unauthorized", api.ErrInvalidCredentials))
+
+		assert.Equal(t, []cty.Path{cty.GetAttrPath("client_id"), cty.GetAttrPath("client_secret")}, diagnosticPaths(diagnostics))
+	})
+
+	t.Run("access token rejected", func(t *testing.T) {
+		diagnostics := diagFromAuthError(fmt.Errorf("%w: GET /api/beta/regions 401 Unauthorized", api.ErrAccessTokenRejected))
+
+		assert.Equal(t, []cty.Path{cty.GetAttrPath("access_token")}, diagnosticPaths(diagnostics))
+	})
+
+	t.Run("network error", func(t *testing.T) {
+		diagnostics := diagFromAuthError(fmt.Errorf("authentication failed: unable to reach https://test.openvpn.com: %w",
+			&url.Error{Op: "Post", URL: "https://test.openvpn.com", Err: errors.New("connection refused")}))
+
+		assert.Equal(t, []cty.Path{cty.GetAttrPath("host")}, diagnosticPaths(diagnostics))
+	})
+
+	t.Run("canceled request", func(t *testing.T) {
+		for _, ctxErr := range []error{context.Canceled, context.DeadlineExceeded} {
+			diagnostics := diagFromAuthError(fmt.Errorf("authentication failed: unable to reach https://test.openvpn.com: %w",
+				&url.Error{Op: "Post", URL: "https://test.openvpn.com", Err: ctxErr}))
+
+			require.Len(t, diagnostics, 1)
+			assert.Nil(t, diagnostics[0].AttributePath)
+		}
+	})
+
+	t.Run("other error", func(t *testing.T) {
+		diagnostics := diagFromAuthError(errors.New("status code: 500"))
+
+		require.Len(t, diagnostics, 1)
+		assert.Equal(t, "status code: 500", diagnostics[0].Summary)
+		assert.Nil(t, diagnostics[0].AttributePath)
+	})
+}
+
+// TestAuthenticationDiagnostics checks that authentication errors of the first API call are reported
+// on the provider attribute causing them.
+func TestAuthenticationDiagnostics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		writer.WriteHeader(http.StatusUnauthorized)
+	}))
+	t.Cleanup(server.Close)
+
+	unreachable := httptest.NewServer(http.NotFoundHandler())
+	unreachable.Close()
+
+	tests := map[string]struct {
+		host     string
+		expected []cty.Path
+	}{
+		"invalid credentials": {server.URL, []cty.Path{cty.GetAttrPath("client_id"), cty.GetAttrPath("client_secret")}},
+		"unreachable host":    {unreachable.URL, []cty.Path{cty.GetAttrPath("host")}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			// given
+			client := api.NewClient(&http.Client{}, &api.AuthConfig{Host: test.host, ClientID: "id", ClientSecret: "secret"})
+			data := schema.TestResourceDataRaw(t, resourceSettingsDns().Schema, map[string]interface{}{})
+
+			// when
+			diagnostics := resourceSettingsDnsRead(context.Background(), data, client)
+
+			// then
+			assert.Equal(t, test.expected, diagnosticPaths(diagnostics))
+		})
+	}
+}
+
+func diagnosticPaths(diagnostics diag.Diagnostics) []cty.Path {
+	paths := make([]cty.Path, len(diagnostics))
+	for i, diagnostic := range diagnostics {
+		paths[i] = diagnostic.AttributePath
+	}
+	return paths
+}
+
 func testAccPreCheck(t *testing.T) {
 	validateEnvVar(t, "OVPN_HOST")
 	validateEnvVar(t, "OVPN_CLIENT_ID")
@@ -0,0 +1,87 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+func dataSourceRegion() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataRegionRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"continent": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"country_iso": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"region_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataRegionRead(ctx context.Context, d *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	filter := regionFilterFromData(d)
+	filter.ID = d.Get("id").(string)
+
+	regions, err := client.ListRegions(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+	regions = filterRegions(regions, filter)
+
+	if len(regions) == 0 {
+		return diag.Errorf("no region matches %s", filter)
+	}
+	if len(regions) > 1 {
+		return diag.Errorf("%d regions match %s, add filters to select a single region", len(regions), filter)
+	}
+
+	return setRegionData(d, regions[0])
+}
+
+func setRegionData(d *schema.ResourceData, region api.Region) diag.Diagnostics {
+	d.SetId(region.ID)
+	err := d.Set("continent", region.Continent)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("country", region.Country)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("country_iso", region.CountryISO)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("region_name", region.RegionName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
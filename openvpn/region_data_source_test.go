@@ -0,0 +1,49 @@
+package openvpn
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"regexp"
+	"testing"
+)
+
+func TestDataRegion(t *testing.T) {
+	dataSourceName := "data.openvpn_region.test"
+
+	client := getAuthenticatedClient(t)
+	regionId := getDefaultRegionID(t, client)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: dataRegionOutputConfig(fmt.Sprintf(`id = "%s"`, regionId)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", regionId),
+					resource.TestCheckResourceAttrSet(dataSourceName, "continent"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "country"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "country_iso"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "region_name"),
+				),
+			},
+			{
+				Config:      dataRegionOutputConfig(`country_iso = "XX"`),
+				ExpectError: regexp.MustCompile("no region matches country_iso = 'XX'"),
+			},
+		},
+	})
+}
+
+func dataRegionOutputConfig(filter string) string {
+	return fmt.Sprintf(`
+provider "openvpn" {
+}
+
+data "openvpn_region" "test" {
+	%s
+}
+`, filter)
+}
@@ -0,0 +1,190 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"sort"
+	"strings"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+const maxRegionSuggestions = 3
+
+type regionFilter struct {
+	ID         string
+	CountryISO string
+	Continent  string
+	Country    string
+	RegionName string
+}
+
+func regionFilterFromData(data *schema.ResourceData) regionFilter {
+	return regionFilter{
+		CountryISO: data.Get("country_iso").(string),
+		Continent:  data.Get("continent").(string),
+		Country:    data.Get("country").(string),
+		RegionName: data.Get("region_name").(string),
+	}
+}
+
+// matches compares every set field of the filter case-insensitively with the region.
+func (f regionFilter) matches(region api.Region) bool {
+	return filterValueMatches(f.ID, region.ID) &&
+		filterValueMatches(f.CountryISO, region.CountryISO) &&
+		filterValueMatches(f.Continent, region.Continent) &&
+		filterValueMatches(f.Country, region.Country) &&
+		filterValueMatches(f.RegionName, region.RegionName)
+}
+
+func (f regionFilter) String() string {
+	var conditions []string
+	for _, condition := range []struct{ name, value string }{
+		{"id", f.ID},
+		{"country_iso", f.CountryISO},
+		{"continent", f.Continent},
+		{"country", f.Country},
+		{"region_name", f.RegionName},
+	} {
+		if condition.value != "" {
+			conditions = append(conditions, fmt.Sprintf("%s = '%s'", condition.name, condition.value))
+		}
+	}
+	if len(conditions) == 0 {
+		return "an empty filter"
+	}
+	return strings.Join(conditions, ", ")
+}
+
+func filterValueMatches(filterValue, value string) bool {
+	return filterValue == "" || strings.EqualFold(filterValue, value)
+}
+
+func filterRegions(regions []api.Region, filter regionFilter) []api.Region {
+	filtered := make([]api.Region, 0, len(regions))
+	for _, region := range regions {
+		if filter.matches(region) {
+			filtered = append(filtered, region)
+		}
+	}
+	return filtered
+}
+
+// customizeDiffVpnRegionID checks the vpn_region_id attribute of a resource against the available regions at plan time.
+func customizeDiffVpnRegionID(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	if !diff.NewValueKnown("vpn_region_id") || (diff.Id() != "" && !diff.HasChange("vpn_region_id")) {
+		return nil
+	}
+
+	client, ok := i.(*api.Client)
+	if !ok {
+		return nil
+	}
+
+	regions, err := client.ListRegions(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to validate vpn_region_id: %w", err)
+	}
+
+	return validateRegionID(regions, diff.Get("vpn_region_id").(string))
+}
+
+// validateRegionID checks that the region exists and otherwise suggests the regions
+// closest to the given value by id, country or region name.
+func validateRegionID(regions []api.Region, regionID string) error {
+	for _, region := range regions {
+		if region.ID == regionID {
+			return nil
+		}
+	}
+
+	suggestions := suggestRegions(regions, regionID)
+	if len(suggestions) == 0 {
+		return fmt.Errorf("vpn_region_id '%s' is not a valid region, use the openvpn_regions data source to list the available regions", regionID)
+	}
+
+	descriptions := make([]string, len(suggestions))
+	for i, region := range suggestions {
+		descriptions[i] = fmt.Sprintf("%s (%s, %s)", region.ID, region.Country, region.RegionName)
+	}
+	return fmt.Errorf("vpn_region_id '%s' is not a valid region, did you mean: %s", regionID, strings.Join(descriptions, ", "))
+}
+
+func suggestRegions(regions []api.Region, value string) []api.Region {
+	value = strings.ToLower(value)
+	if value == "" {
+		return nil
+	}
+
+	type scoredRegion struct {
+		region api.Region
+		score  int
+	}
+	var candidates []scoredRegion
+	for _, region := range regions {
+		score := -1
+		for _, field := range []string{region.ID, region.Country, region.CountryISO, region.RegionName} {
+			field = strings.ToLower(field)
+			if field == "" {
+				continue
+			}
+			fieldScore := levenshteinDistance(value, field)
+			if strings.Contains(field, value) {
+				fieldScore = 0
+			}
+			if score < 0 || fieldScore < score {
+				score = fieldScore
+			}
+		}
+		// Only regions that share at least half of the value are worth suggesting.
+		if score >= 0 && score <= len(value)/2 {
+			candidates = append(candidates, scoredRegion{region: region, score: score})
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].score != candidates[j].score {
+			return candidates[i].score < candidates[j].score
+		}
+		return candidates[i].region.ID < candidates[j].region.ID
+	})
+
+	if len(candidates) > maxRegionSuggestions {
+		candidates = candidates[:maxRegionSuggestions]
+	}
+	suggestions := make([]api.Region, len(candidates))
+	for i, candidate := range candidates {
+		suggestions[i] = candidate.region
+	}
+	return suggestions
+}
+
+func levenshteinDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	previous := make([]int, len(br)+1)
+	current := make([]int, len(br)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(ar); i++ {
+		current[0] = i
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(minInt(previous[j]+1, current[j-1]+1), previous[j-1]+cost)
+		}
+		previous, current = current, previous
+	}
+
+	return previous[len(br)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -2,8 +2,12 @@ package openvpn
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"sort"
 	"terraform-provider-openvpn/openvpn/api"
 )
 
@@ -11,6 +15,22 @@ func dataSourceRegions() *schema.Resource {
 	return &schema.Resource{
 		ReadContext: dataRegionsRead,
 		Schema: map[string]*schema.Schema{
+			"country_iso": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"continent": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"region_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"regions": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -39,6 +59,16 @@ func dataSourceRegions() *schema.Resource {
 					},
 				},
 			},
+			"region_ids": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"regions_by_id": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 		},
 	}
 }
@@ -50,12 +80,16 @@ func dataRegionsRead(ctx context.Context, d *schema.ResourceData, i interface{})
 	}
 	regions, err := client.ListRegions(ctx)
 	if err != nil {
-		return diag.FromErr(err)
+		return diagFromAuthError(err)
 	}
-	regionsData := make([]map[string]interface{}, len(regions))
-
-	combinedID := ""
+	regions = filterRegions(regions, regionFilterFromData(d))
+	sort.Slice(regions, func(i, j int) bool {
+		return regions[i].ID < regions[j].ID
+	})
 
+	regionsData := make([]map[string]interface{}, len(regions))
+	regionIDs := make([]string, len(regions))
+	regionsByID := make(map[string]interface{}, len(regions))
 	for i, region := range regions {
 		regionsData[i] = map[string]interface{}{
 			"id":          region.ID,
@@ -64,16 +98,35 @@ func dataRegionsRead(ctx context.Context, d *schema.ResourceData, i interface{})
 			"country_iso": region.CountryISO,
 			"region_name": region.RegionName,
 		}
-
-		combinedID += region.ID
+		regionIDs[i] = region.ID
+		regionsByID[region.ID] = region.RegionName
 	}
 
 	err = d.Set("regions", regionsData)
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	err = d.Set("region_ids", regionIDs)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = d.Set("regions_by_id", regionsByID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-	d.SetId(combinedID)
+	d.SetId(regionsHash(regions))
 
 	return nil
 }
+
+// regionsHash identifies a sorted list of regions by its contents, so the id only changes
+// when the regions themselves do.
+func regionsHash(regions []api.Region) string {
+	hash := sha256.New()
+	for _, region := range regions {
+		_, _ = fmt.Fprintf(hash, "%q %q %q %q %q\n",
+			region.ID, region.Continent, region.Country, region.CountryISO, region.RegionName)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
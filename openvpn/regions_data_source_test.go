@@ -3,6 +3,8 @@ package openvpn
 import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-openvpn/openvpn/api"
 	"testing"
 )
 
@@ -27,8 +29,10 @@ func TestDataRegions(t *testing.T) {
 				Config: dataRegionsOutputConfig,
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet(dataSourceName, "id"),
-					resource.TestCheckResourceAttr(dataSourceName, "%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "%", "4"),
 					resource.TestCheckResourceAttrSet(dataSourceName, "regions.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "region_ids.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "regions_by_id.%"),
 					resource.TestCheckResourceAttr(dataSourceName, "regions.0.%", "5"),
 					testCheckResourceAttributesMapSet(dataSourceName, "regions.0",
 						"id", "continent", "country", "country_iso", "region_name"),
@@ -47,3 +51,16 @@ func testCheckResourceAttributesMapSet(name, attrName string, keys ...string) re
 	return resource.ComposeAggregateTestCheckFunc(testCheckFuncs...)
 }
 
+func TestRegionsHash(t *testing.T) {
+	regions := []api.Region{
+		{ID: "eu-central-1", Continent: "Europe", Country: "Germany", CountryISO: "DE", RegionName: "Frankfurt"},
+		{ID: "us-east-1", Continent: "North America", Country: "United States", CountryISO: "US", RegionName: "Ashburn"},
+	}
+	renamed := []api.Region{regions[0], regions[1]}
+	renamed[1].RegionName = "Virginia"
+
+	assert.Equal(t, regionsHash(regions), regionsHash([]api.Region{regions[0], regions[1]}))
+	assert.NotEqual(t, regionsHash(regions), regionsHash(renamed))
+	assert.NotEqual(t, regionsHash(regions), regionsHash(regions[:1]))
+	assert.Len(t, regionsHash(nil), 64)
+}
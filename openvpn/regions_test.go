@@ -0,0 +1,65 @@
+package openvpn
+
+import (
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+var testRegions = []api.Region{
+	{ID: "us-west-1", Continent: "North America", Country: "United States", CountryISO: "US", RegionName: "US West"},
+	{ID: "us-east-1", Continent: "North America", Country: "United States", CountryISO: "US", RegionName: "US East"},
+	{ID: "eu-central-1", Continent: "Europe", Country: "Germany", CountryISO: "DE", RegionName: "Frankfurt"},
+	{ID: "ap-southeast-1", Continent: "Asia", Country: "Singapore", CountryISO: "SG", RegionName: "Singapore"},
+}
+
+func TestValidateRegionID(t *testing.T) {
+	t.Run("existing region", func(t *testing.T) {
+		assert.NoError(t, validateRegionID(testRegions, "eu-central-1"))
+	})
+
+	t.Run("typo in id", func(t *testing.T) {
+		err := validateRegionID(testRegions, "us-wst-1")
+
+		assert.EqualError(t, err, "vpn_region_id 'us-wst-1' is not a valid region, did you mean: us-west-1 (United States, US West), us-east-1 (United States, US East)")
+	})
+
+	t.Run("country name", func(t *testing.T) {
+		err := validateRegionID(testRegions, "germany")
+
+		assert.EqualError(t, err, "vpn_region_id 'germany' is not a valid region, did you mean: eu-central-1 (Germany, Frankfurt)")
+	})
+
+	t.Run("no match", func(t *testing.T) {
+		err := validateRegionID(testRegions, "antarctica-research-station")
+
+		assert.EqualError(t, err, "vpn_region_id 'antarctica-research-station' is not a valid region, use the openvpn_regions data source to list the available regions")
+	})
+}
+
+func TestFilterRegions(t *testing.T) {
+	t.Run("case insensitive", func(t *testing.T) {
+		regions := filterRegions(testRegions, regionFilter{Country: "united states", RegionName: "US West"})
+
+		assert.Equal(t, []api.Region{testRegions[0]}, regions)
+	})
+
+	t.Run("empty filter", func(t *testing.T) {
+		regions := filterRegions(testRegions, regionFilter{})
+
+		assert.Equal(t, testRegions, regions)
+	})
+
+	t.Run("continent", func(t *testing.T) {
+		regions := filterRegions(testRegions, regionFilter{Continent: "North America"})
+
+		assert.Equal(t, []api.Region{testRegions[0], testRegions[1]}, regions)
+	})
+}
+
+func TestLevenshteinDistance(t *testing.T) {
+	assert.Equal(t, 0, levenshteinDistance("us-west-1", "us-west-1"))
+	assert.Equal(t, 1, levenshteinDistance("us-wst-1", "us-west-1"))
+	assert.Equal(t, 3, levenshteinDistance("kitten", "sitting"))
+	assert.Equal(t, 5, levenshteinDistance("", "hello"))
+}
@@ -0,0 +1,202 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+// serviceConfigSchema describes the protocols and ports a service exposes, it is shared by
+// every resource that publishes services. Without the block the service is not restricted.
+func serviceConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"service_types": {
+					Type:     schema.TypeSet,
+					Optional: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(api.ServiceTypePossibleValues, false),
+					},
+				},
+				"custom_service_types": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"protocol": {
+								Type:     schema.TypeString,
+								Required: true,
+								ValidateDiagFunc: func(i interface{}, path cty.Path) diag.Diagnostics {
+									value := api.ServiceProtocol(i.(string))
+									return diag.FromErr(value.Validate())
+								},
+							},
+							"port":      serviceRangeSchema(1, 65535),
+							"icmp_type": serviceRangeSchema(0, 255),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func serviceRangeSchema(min, max int) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"from": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(min, max),
+				},
+				"to": {
+					Type:         schema.TypeInt,
+					Required:     true,
+					ValidateFunc: validation.IntBetween(min, max),
+				},
+			},
+		},
+	}
+}
+
+// expandServiceConfig builds the api config from the config block, the block is checked by customizeDiffServiceConfig.
+// A missing block is sent as an empty config, so removing the block lifts the restrictions of the service.
+func expandServiceConfig(configList []interface{}) *api.ServiceConfig {
+	config := &api.ServiceConfig{
+		ServiceTypes:       []string{},
+		CustomServiceTypes: []api.CustomServiceType{},
+	}
+	if len(configList) == 0 || configList[0] == nil {
+		return config
+	}
+	configData := configList[0].(map[string]interface{})
+
+	if serviceTypes, ok := configData["service_types"].(*schema.Set); ok {
+		for _, serviceType := range serviceTypes.List() {
+			config.ServiceTypes = append(config.ServiceTypes, serviceType.(string))
+		}
+	}
+
+	customServiceTypes, _ := configData["custom_service_types"].([]interface{})
+	for _, customServiceTypeI := range customServiceTypes {
+		customServiceTypeData := customServiceTypeI.(map[string]interface{})
+		config.CustomServiceTypes = append(config.CustomServiceTypes, api.CustomServiceType{
+			Protocol: api.ServiceProtocol(customServiceTypeData["protocol"].(string)),
+			Port:     expandServiceRanges(customServiceTypeData["port"]),
+			IcmpType: expandServiceRanges(customServiceTypeData["icmp_type"]),
+		})
+	}
+
+	return config
+}
+
+func expandServiceRanges(rangesI interface{}) []api.Range {
+	rangesList, _ := rangesI.([]interface{})
+	ranges := make([]api.Range, 0, len(rangesList))
+	for _, rangeI := range rangesList {
+		rangeData := rangeI.(map[string]interface{})
+		ranges = append(ranges, api.Range{
+			LowerValue: rangeData["from"].(int),
+			UpperValue: rangeData["to"].(int),
+		})
+	}
+	return ranges
+}
+
+// customizeDiffServiceConfig checks the config block of a resource at plan time. Ports are only valid for TCP and
+// UDP, ICMP services are restricted by icmp_type instead. Values only known after apply are not checked.
+func customizeDiffServiceConfig(ctx context.Context, diff *schema.ResourceDiff, i interface{}) error {
+	customServiceTypes, _ := diff.Get("config.0.custom_service_types").([]interface{})
+	for i := range customServiceTypes {
+		attribute := fmt.Sprintf("config.0.custom_service_types.%d", i)
+		err := validateServiceRanges(diff, attribute+".port")
+		if err != nil {
+			return err
+		}
+		err = validateServiceRanges(diff, attribute+".icmp_type")
+		if err != nil {
+			return err
+		}
+
+		if !diff.NewValueKnown(attribute + ".protocol") {
+			continue
+		}
+		protocol := api.ServiceProtocol(diff.Get(attribute + ".protocol").(string))
+		ports, _ := diff.Get(attribute + ".port").([]interface{})
+		icmpTypes, _ := diff.Get(attribute + ".icmp_type").([]interface{})
+		if protocol == api.ServiceProtocolICMP && len(ports) > 0 {
+			return fmt.Errorf("%s: port can not be set for protocol ICMP, use icmp_type", attribute)
+		}
+		if protocol != api.ServiceProtocolICMP && len(icmpTypes) > 0 {
+			return fmt.Errorf("%s: icmp_type can only be set for protocol ICMP", attribute)
+		}
+	}
+	return nil
+}
+
+func validateServiceRanges(diff *schema.ResourceDiff, attribute string) error {
+	ranges, _ := diff.Get(attribute).([]interface{})
+	for i := range ranges {
+		rangeAttribute := fmt.Sprintf("%s.%d", attribute, i)
+		if !diff.NewValueKnown(rangeAttribute+".from") || !diff.NewValueKnown(rangeAttribute+".to") {
+			continue
+		}
+		from := diff.Get(rangeAttribute + ".from").(int)
+		to := diff.Get(rangeAttribute + ".to").(int)
+		if from > to {
+			return fmt.Errorf("%s: from (%d) must not be greater than to (%d)", rangeAttribute, from, to)
+		}
+	}
+	return nil
+}
+
+// flattenServiceConfig leaves out an empty config, it is what the API reports for a service configured without the block.
+func flattenServiceConfig(config *api.ServiceConfig) []interface{} {
+	if config == nil || (len(config.ServiceTypes) == 0 && len(config.CustomServiceTypes) == 0) {
+		return []interface{}{}
+	}
+
+	customServiceTypes := make([]interface{}, len(config.CustomServiceTypes))
+	for i, customServiceType := range config.CustomServiceTypes {
+		customServiceTypes[i] = map[string]interface{}{
+			"protocol":  string(customServiceType.Protocol),
+			"port":      flattenServiceRanges(customServiceType.Port),
+			"icmp_type": flattenServiceRanges(customServiceType.IcmpType),
+		}
+	}
+
+	serviceTypes := make([]interface{}, len(config.ServiceTypes))
+	for i, serviceType := range config.ServiceTypes {
+		serviceTypes[i] = serviceType
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"service_types":        serviceTypes,
+			"custom_service_types": customServiceTypes,
+		},
+	}
+}
+
+func flattenServiceRanges(ranges []api.Range) []interface{} {
+	rangesData := make([]interface{}, len(ranges))
+	for i, serviceRange := range ranges {
+		rangesData[i] = map[string]interface{}{
+			"from": serviceRange.LowerValue,
+			"to":   serviceRange.UpperValue,
+		}
+	}
+	return rangesData
+}
@@ -0,0 +1,161 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+// unknownValue marks a raw config value as only known after apply, like hcl2shim.UnknownVariableValue.
+const unknownValue = "74D93920-ED26-11E3-AC10-0800200C9A66"
+
+func TestExpandServiceConfig(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		// given
+		data := schema.TestResourceDataRaw(t, resourceIPService().Schema, map[string]interface{}{
+			"config": []interface{}{
+				map[string]interface{}{
+					"service_types": []interface{}{"SSH"},
+					"custom_service_types": []interface{}{
+						map[string]interface{}{
+							"protocol": "TCP",
+							"port":     []interface{}{map[string]interface{}{"from": 8000, "to": 8080}},
+						},
+						map[string]interface{}{
+							"protocol":  "ICMP",
+							"icmp_type": []interface{}{map[string]interface{}{"from": 8, "to": 8}},
+						},
+					},
+				},
+			},
+		})
+
+		// when
+		config := expandServiceConfig(data.Get("config").([]interface{}))
+
+		// then
+		assert.Equal(t, &api.ServiceConfig{
+			ServiceTypes: []string{"SSH"},
+			CustomServiceTypes: []api.CustomServiceType{
+				{Protocol: api.ServiceProtocolTCP, Port: []api.Range{{LowerValue: 8000, UpperValue: 8080}}, IcmpType: []api.Range{}},
+				{Protocol: api.ServiceProtocolICMP, Port: []api.Range{}, IcmpType: []api.Range{{LowerValue: 8, UpperValue: 8}}},
+			},
+		}, config)
+		require.NoError(t, data.Set("config", flattenServiceConfig(config)))
+		assert.Equal(t, 8080, data.Get("config.0.custom_service_types.0.port.0.to"))
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		config := expandServiceConfig([]interface{}{})
+
+		assert.Equal(t, &api.ServiceConfig{ServiceTypes: []string{}, CustomServiceTypes: []api.CustomServiceType{}}, config)
+		assert.Empty(t, flattenServiceConfig(config))
+	})
+}
+
+func TestServiceConfigSchema_removeBlock(t *testing.T) {
+	// given
+	state := &terraform.InstanceState{
+		ID: "service-id",
+		Attributes: map[string]string{
+			"id":                              "service-id",
+			"name":                            "service",
+			"network_item_id":                 "host-id",
+			"network_item_type":               "HOST",
+			"type":                            "SERVICE_DESTINATION",
+			"routes.#":                        "1",
+			"routes.0":                        "10.0.0.1/32",
+			"config.#":                        "1",
+			"config.0.service_types.#":        "0",
+			"config.0.custom_service_types.#": "1",
+			"config.0.custom_service_types.0.protocol": "TCP",
+		},
+	}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"name":              "service",
+		"network_item_id":   "host-id",
+		"network_item_type": "HOST",
+		"type":              "SERVICE_DESTINATION",
+		"routes":            []interface{}{"10.0.0.1/32"},
+	})
+
+	// when
+	diff, err := resourceIPService().Diff(context.Background(), state, config, nil)
+
+	// then
+	require.NoError(t, err)
+	require.NotNil(t, diff)
+	require.Contains(t, diff.Attributes, "config.#")
+	assert.Equal(t, "0", diff.Attributes["config.#"].New)
+}
+
+func TestCustomizeDiffServiceConfig(t *testing.T) {
+	for name, test := range map[string]struct {
+		customServiceType map[string]interface{}
+		err               string
+	}{
+		"port for TCP": {
+			customServiceType: map[string]interface{}{
+				"protocol": "TCP",
+				"port":     []interface{}{map[string]interface{}{"from": 80, "to": 443}},
+			},
+		},
+		"port for ICMP": {
+			customServiceType: map[string]interface{}{
+				"protocol": "ICMP",
+				"port":     []interface{}{map[string]interface{}{"from": 1, "to": 2}},
+			},
+			err: "config.0.custom_service_types.0: port can not be set for protocol ICMP, use icmp_type",
+		},
+		"icmp_type for UDP": {
+			customServiceType: map[string]interface{}{
+				"protocol":  "UDP",
+				"icmp_type": []interface{}{map[string]interface{}{"from": 1, "to": 2}},
+			},
+			err: "config.0.custom_service_types.0: icmp_type can only be set for protocol ICMP",
+		},
+		"inverted range": {
+			customServiceType: map[string]interface{}{
+				"protocol": "TCP",
+				"port":     []interface{}{map[string]interface{}{"from": 443, "to": 80}},
+			},
+			err: "config.0.custom_service_types.0.port.0: from (443) must not be greater than to (80)",
+		},
+		"unknown values": {
+			customServiceType: map[string]interface{}{
+				"protocol": unknownValue,
+				"port":     []interface{}{map[string]interface{}{"from": 443, "to": unknownValue}},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			// given
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"name":              "service",
+				"network_item_id":   "host-id",
+				"network_item_type": "HOST",
+				"type":              "SERVICE_DESTINATION",
+				"routes":            []interface{}{"10.0.0.1/32"},
+				"config": []interface{}{
+					map[string]interface{}{
+						"custom_service_types": []interface{}{test.customServiceType},
+					},
+				},
+			})
+
+			// when
+			_, err := resourceIPService().Diff(context.Background(), nil, config, nil)
+
+			// then
+			if test.err == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.EqualError(t, err, test.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+const settingsAutoConnectID = "auto_connect"
+
+// resourceSettingsAutoConnect manages whether clients connect automatically once they are started.
+func resourceSettingsAutoConnect() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Whether clients connect automatically. Destroying the resource resets auto-connect to the tenant default.",
+		CreateContext: resourceSettingsAutoConnectUpdate,
+		ReadContext:   resourceSettingsAutoConnectRead,
+		UpdateContext: resourceSettingsAutoConnectUpdate,
+		DeleteContext: resourceSettingsAutoConnectDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"enabled": {
+				Type:     schema.TypeBool,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceSettingsAutoConnectUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	autoConnect, err := client.UpdateAutoConnect(ctx, &api.AutoConnect{
+		Enabled: data.Get("enabled").(bool),
+	})
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setSettingsAutoConnectData(data, autoConnect)
+}
+
+func resourceSettingsAutoConnectRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	autoConnect, err := client.GetAutoConnect(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setSettingsAutoConnectData(data, autoConnect)
+}
+
+func resourceSettingsAutoConnectDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	err := client.ResetAutoConnect(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return nil
+}
+
+func setSettingsAutoConnectData(data *schema.ResourceData, autoConnect *api.AutoConnect) diag.Diagnostics {
+	data.SetId(settingsAutoConnectID)
+	err := data.Set("enabled", autoConnect.Enabled)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+const settingsDefaultRegionID = "default_region"
+
+// resourceSettingsDefaultRegion manages the VPN region new users and connectors default to. A default region
+// is always set, so the region set before the resource was created is recorded to restore it on destroy.
+func resourceSettingsDefaultRegion() *schema.Resource {
+	return &schema.Resource{
+		Description:   "The default VPN region of the tenant. Destroying the resource sets the default region back to the one set before the resource was created.",
+		CreateContext: resourceSettingsDefaultRegionCreate,
+		ReadContext:   resourceSettingsDefaultRegionRead,
+		UpdateContext: resourceSettingsDefaultRegionUpdate,
+		DeleteContext: resourceSettingsDefaultRegionDelete,
+		CustomizeDiff: customizeDiffVpnRegionID,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"vpn_region_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"previous_vpn_region_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSettingsDefaultRegionCreate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	previous, err := client.GetDefaultRegion(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+	err = data.Set("previous_vpn_region_id", previous.VpnRegionId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceSettingsDefaultRegionUpdate(ctx, data, i)
+}
+
+func resourceSettingsDefaultRegionUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	defaultRegion, err := client.UpdateDefaultRegion(ctx, &api.DefaultRegion{
+		VpnRegionId: data.Get("vpn_region_id").(string),
+	})
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setSettingsDefaultRegionData(data, defaultRegion)
+}
+
+func resourceSettingsDefaultRegionRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	defaultRegion, err := client.GetDefaultRegion(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setSettingsDefaultRegionData(data, defaultRegion)
+}
+
+func resourceSettingsDefaultRegionDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	previousRegionId := data.Get("previous_vpn_region_id").(string)
+	if previousRegionId == "" {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  "unable to restore the default region",
+				Detail: "the default region set before this resource was created is unknown, " +
+					"remove the resource from the state with terraform state rm to keep the current default region",
+			},
+		}
+	}
+
+	_, err := client.UpdateDefaultRegion(ctx, &api.DefaultRegion{VpnRegionId: previousRegionId})
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return nil
+}
+
+func setSettingsDefaultRegionData(data *schema.ResourceData, defaultRegion *api.DefaultRegion) diag.Diagnostics {
+	data.SetId(settingsDefaultRegionID)
+	err := data.Set("vpn_region_id", defaultRegion.VpnRegionId)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
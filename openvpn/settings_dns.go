@@ -0,0 +1,96 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+const settingsDnsID = "dns"
+
+// resourceSettingsDns manages the DNS servers clients of the tenant resolve names with.
+func resourceSettingsDns() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Tenant wide DNS servers. Destroying the resource removes the custom servers, clients then use the DNS servers provided by OpenVPN.",
+		CreateContext: resourceSettingsDnsUpdate,
+		ReadContext:   resourceSettingsDnsRead,
+		UpdateContext: resourceSettingsDnsUpdate,
+		DeleteContext: resourceSettingsDnsDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"primary_ip_v4": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+			"secondary_ip_v4": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsIPv4Address,
+			},
+		},
+	}
+}
+
+func resourceSettingsDnsUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	dnsServers, err := client.UpdateDnsServers(ctx, &api.DnsServers{
+		PrimaryIpV4:   data.Get("primary_ip_v4").(string),
+		SecondaryIpV4: data.Get("secondary_ip_v4").(string),
+	})
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setSettingsDnsData(data, dnsServers)
+}
+
+func resourceSettingsDnsRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	dnsServers, err := client.GetDnsServers(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setSettingsDnsData(data, dnsServers)
+}
+
+func resourceSettingsDnsDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	err := client.ResetDnsServers(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return nil
+}
+
+func setSettingsDnsData(data *schema.ResourceData, dnsServers *api.DnsServers) diag.Diagnostics {
+	data.SetId(settingsDnsID)
+	err := data.Set("primary_ip_v4", dnsServers.PrimaryIpV4)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("secondary_ip_v4", dnsServers.SecondaryIpV4)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+const settingsDomainRoutingSubnetID = "domain_routing_subnet"
+
+// resourceSettingsDomainRoutingSubnet manages the subnets addresses of domain routed applications are assigned from.
+func resourceSettingsDomainRoutingSubnet() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Subnets the addresses of domain routed applications are assigned from. Destroying the resource assigns new addresses from the default subnets again.",
+		CreateContext: resourceSettingsDomainRoutingSubnetUpdate,
+		ReadContext:   resourceSettingsDomainRoutingSubnetRead,
+		UpdateContext: resourceSettingsDomainRoutingSubnetUpdate,
+		DeleteContext: resourceSettingsDomainRoutingSubnetDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ip_v4_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIPv4CIDR,
+			},
+			"ip_v6_address": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateIPv6CIDR,
+			},
+		},
+	}
+}
+
+func resourceSettingsDomainRoutingSubnetUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	subnet, err := client.UpdateDomainRoutingSubnet(ctx, &api.DomainRoutingSubnet{
+		IpV4Address: data.Get("ip_v4_address").(string),
+		IpV6Address: data.Get("ip_v6_address").(string),
+	})
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setSettingsDomainRoutingSubnetData(data, subnet)
+}
+
+func resourceSettingsDomainRoutingSubnetRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	subnet, err := client.GetDomainRoutingSubnet(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return setSettingsDomainRoutingSubnetData(data, subnet)
+}
+
+func resourceSettingsDomainRoutingSubnetDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	err := client.ResetDomainRoutingSubnet(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	return nil
+}
+
+func setSettingsDomainRoutingSubnetData(data *schema.ResourceData, subnet *api.DomainRoutingSubnet) diag.Diagnostics {
+	data.SetId(settingsDomainRoutingSubnetID)
+	err := data.Set("ip_v4_address", subnet.IpV4Address)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("ip_v6_address", subnet.IpV6Address)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
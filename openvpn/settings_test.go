@@ -0,0 +1,183 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+func TestResourceSettingsDns_basic(t *testing.T) {
+	resourceName := "openvpn_settings_dns.test"
+	client := getAuthenticatedClient(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		CheckDestroy: func(s *terraform.State) error {
+			dnsServers, err := client.GetDnsServers(context.Background())
+			if err != nil {
+				return err
+			}
+			if dnsServers.PrimaryIpV4 == "192.0.2.53" {
+				return fmt.Errorf("dns servers were not reset")
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openvpn" {}
+
+resource "openvpn_settings_dns" "test" {
+	primary_ip_v4 = "192.0.2.53"
+	secondary_ip_v4 = "198.51.100.53"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", settingsDnsID),
+					resource.TestCheckResourceAttr(resourceName, "primary_ip_v4", "192.0.2.53"),
+					resource.TestCheckResourceAttr(resourceName, "secondary_ip_v4", "198.51.100.53"),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceSettingsDefaultRegion_basic(t *testing.T) {
+	resourceName := "openvpn_settings_default_region.test"
+	client := getAuthenticatedClient(t)
+
+	original, err := client.GetDefaultRegion(context.Background())
+	require.NoError(t, err)
+
+	regionID := getDefaultRegionID(t, client)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		CheckDestroy: func(state *terraform.State) error {
+			defaultRegion, err := client.GetDefaultRegion(context.Background())
+			if err != nil {
+				return err
+			}
+			if defaultRegion.VpnRegionId != original.VpnRegionId {
+				return fmt.Errorf("default region %s was not restored to %s", defaultRegion.VpnRegionId, original.VpnRegionId)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "openvpn" {}
+
+resource "openvpn_settings_default_region" "test" {
+	vpn_region_id = "%s"
+}
+`, regionID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", settingsDefaultRegionID),
+					resource.TestCheckResourceAttr(resourceName, "vpn_region_id", regionID),
+					resource.TestCheckResourceAttr(resourceName, "previous_vpn_region_id", original.VpnRegionId),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceSettingsDefaultRegionDelete_unknownPreviousRegion(t *testing.T) {
+	// given
+	data := schema.TestResourceDataRaw(t, resourceSettingsDefaultRegion().Schema, map[string]interface{}{
+		"vpn_region_id": "us-east-1",
+	})
+	data.SetId(settingsDefaultRegionID)
+
+	// when
+	diags := resourceSettingsDefaultRegionDelete(context.Background(), data, &api.Client{})
+
+	// then
+	require.True(t, diags.HasError())
+	assert.Equal(t, "unable to restore the default region", diags[0].Summary)
+}
+
+func TestResourceSettingsAutoConnect_basic(t *testing.T) {
+	resourceName := "openvpn_settings_auto_connect.test"
+	getAuthenticatedClient(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: resourceSettingsAutoConnectOutputConfig(true),
+				Check:  resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+			},
+			{
+				Config: resourceSettingsAutoConnectOutputConfig(false),
+				Check:  resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+			},
+		},
+	})
+}
+
+func TestResourceSettingsDomainRoutingSubnet_basic(t *testing.T) {
+	resourceName := "openvpn_settings_domain_routing_subnet.test"
+	client := getAuthenticatedClient(t)
+
+	original, err := client.GetDomainRoutingSubnet(context.Background())
+	require.NoError(t, err)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		CheckDestroy: func(s *terraform.State) error {
+			subnet, err := client.GetDomainRoutingSubnet(context.Background())
+			if err != nil {
+				return err
+			}
+			if *subnet != *original {
+				return fmt.Errorf("domain routing subnet was not reset: %+v", subnet)
+			}
+			return nil
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "openvpn" {}
+
+resource "openvpn_settings_domain_routing_subnet" "test" {
+	ip_v4_address = "100.80.0.0/12"
+	ip_v6_address = "fd00:0:0:4000::/50"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", settingsDomainRoutingSubnetID),
+					resource.TestCheckResourceAttr(resourceName, "ip_v4_address", "100.80.0.0/12"),
+				),
+			},
+		},
+	})
+}
+
+func resourceSettingsAutoConnectOutputConfig(enabled bool) string {
+	return fmt.Sprintf(`
+provider "openvpn" {}
+
+resource "openvpn_settings_auto_connect" "test" {
+	enabled = %t
+}
+`, enabled)
+}
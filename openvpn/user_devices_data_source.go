@@ -0,0 +1,52 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+func dataSourceUserDevices() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserDevicesRead,
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"devices": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: deviceFields(),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceUserDevicesRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	userId := data.Get("user_id").(string)
+	devices, err := client.ListUserDevices(ctx, userId)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	devicesData := make([]interface{}, len(devices))
+	for i, device := range devices {
+		devicesData[i] = flattenDevice(device)
+	}
+
+	data.SetId(userId)
+	err = data.Set("devices", devicesData)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package openvpn
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestDataSourceUserDevices(t *testing.T) {
+	dataSourceName := "data.openvpn_user_devices.test"
+
+	getAuthenticatedClient(t)
+	userID := getTestUserID(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: dataUserDevicesOutputConfig(userID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", userID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "devices.0.id"),
+					resource.TestCheckResourceAttr(dataSourceName, "devices.0.user_id", userID),
+				),
+			},
+		},
+	})
+}
+
+func dataUserDevicesOutputConfig(userID string) string {
+	return fmt.Sprintf(`
+provider "openvpn" {
+}
+
+data "openvpn_user_devices" "test" {
+  user_id = "%s"
+}
+`, userID)
+}
@@ -0,0 +1,188 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"sort"
+	"strings"
+	"terraform-provider-openvpn/openvpn/api"
+)
+
+// resourceUserGroupMembership assigns users to a group. By default it only manages the listed users, so several
+// modules can add members to the same group, the authoritative variant removes every other member of the group.
+func resourceUserGroupMembership() *schema.Resource {
+	return &schema.Resource{
+		Description: "Users of a user group. By default only the listed users are managed and other members of the group are left untouched. " +
+			"With `authoritative` enabled the listed users become the only members of the group. " +
+			"Memberships are changed by rewriting the user, changes made to a user elsewhere while it is updated are lost.",
+		CreateContext: resourceUserGroupMembershipUpdate,
+		ReadContext:   resourceUserGroupMembershipRead,
+		UpdateContext: resourceUserGroupMembershipUpdate,
+		DeleteContext: resourceUserGroupMembershipDelete,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"usernames": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"authoritative": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func resourceUserGroupMembershipUpdate(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	groupID := data.Get("group_id").(string)
+	previous, desired := data.GetChange("usernames")
+
+	users, err := client.ListUsers(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	add, remove, missing := planGroupMembership(users, groupID,
+		usernameSet(previous.(*schema.Set)), usernameSet(desired.(*schema.Set)), data.Get("authoritative").(bool))
+	if len(missing) > 0 {
+		return diag.Errorf("users not found: %s", strings.Join(missing, ", "))
+	}
+
+	for _, user := range add {
+		err = client.AddUserToGroup(ctx, user.ID, groupID)
+		if err != nil {
+			return diagFromAuthError(fmt.Errorf("adding user %s to group %s: %w", user.Username, groupID, err))
+		}
+	}
+	for _, user := range remove {
+		err = client.RemoveUserFromGroup(ctx, user.ID, groupID)
+		if err != nil {
+			return diagFromAuthError(fmt.Errorf("removing user %s from group %s: %w", user.Username, groupID, err))
+		}
+	}
+
+	data.SetId(groupID)
+	return resourceUserGroupMembershipRead(ctx, data, i)
+}
+
+func resourceUserGroupMembershipRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	users, err := client.ListUsers(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	usernames := groupMembers(users, data.Id(), usernameSet(data.Get("usernames").(*schema.Set)), data.Get("authoritative").(bool))
+	return setUserGroupMembershipData(data, usernames)
+}
+
+func resourceUserGroupMembershipDelete(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	users, err := client.ListUsers(ctx)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	// users deleted in the meantime are no members anymore, so the missing ones are ignored
+	_, remove, _ := planGroupMembership(users, data.Id(), usernameSet(data.Get("usernames").(*schema.Set)), map[string]bool{}, false)
+	for _, user := range remove {
+		err = client.RemoveUserFromGroup(ctx, user.ID, data.Id())
+		if err != nil {
+			return diagFromAuthError(fmt.Errorf("removing user %s from group %s: %w", user.Username, data.Id(), err))
+		}
+	}
+
+	return nil
+}
+
+// planGroupMembership returns the users to add to and remove from the group to reach the desired usernames and
+// the desired usernames without a user. Members that were not previously managed are only removed when
+// authoritative is enabled.
+func planGroupMembership(users []api.User, groupID string, previous, desired map[string]bool, authoritative bool) (add, remove []api.User, missing []string) {
+	found := map[string]bool{}
+	for _, user := range users {
+		found[user.Username] = true
+		member := isGroupMember(user, groupID)
+		switch {
+		case desired[user.Username] && !member:
+			add = append(add, user)
+		case !desired[user.Username] && member && (authoritative || previous[user.Username]):
+			remove = append(remove, user)
+		}
+	}
+
+	for username := range desired {
+		if !found[username] {
+			missing = append(missing, username)
+		}
+	}
+	sort.Strings(missing)
+	return add, remove, missing
+}
+
+// groupMembers returns the usernames of the members of the group, limited to the managed usernames unless
+// authoritative is enabled.
+func groupMembers(users []api.User, groupID string, managed map[string]bool, authoritative bool) []string {
+	usernames := []string{}
+	for _, user := range users {
+		if isGroupMember(user, groupID) && (authoritative || managed[user.Username]) {
+			usernames = append(usernames, user.Username)
+		}
+	}
+	sort.Strings(usernames)
+	return usernames
+}
+
+func isGroupMember(user api.User, groupID string) bool {
+	for _, id := range user.GroupIds {
+		if id == groupID {
+			return true
+		}
+	}
+	return false
+}
+
+func usernameSet(set *schema.Set) map[string]bool {
+	usernames := map[string]bool{}
+	for _, username := range expandStringSet(set) {
+		usernames[username] = true
+	}
+	return usernames
+}
+
+func setUserGroupMembershipData(data *schema.ResourceData, usernames []string) diag.Diagnostics {
+	err := data.Set("group_id", data.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = data.Set("usernames", usernames)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
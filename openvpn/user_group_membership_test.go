@@ -0,0 +1,124 @@
+package openvpn
+
+import (
+	"context"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"terraform-provider-openvpn/openvpn/api"
+	"testing"
+)
+
+func TestResourceUserGroupMembership_basic(t *testing.T) {
+	resourceName := "openvpn_user_group_membership.test"
+
+	client := getAuthenticatedClient(t)
+	groupID := getTestUserGroupID(t)
+	username := getTestUsername(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		CheckDestroy:      testAccCheckUserGroupMembershipDestroy(client, username),
+		Steps: []resource.TestStep{
+			{
+				Config: resourceUserGroupMembershipOutputConfig("test", groupID, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", groupID),
+					resource.TestCheckResourceAttr(resourceName, "usernames.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "usernames.*", username),
+				),
+			},
+		},
+	})
+}
+
+func TestPlanGroupMembership(t *testing.T) {
+	users := []api.User{
+		{ID: "1", Username: "alice", GroupIds: []string{"group"}},
+		{ID: "2", Username: "bob"},
+		{ID: "3", Username: "carol", GroupIds: []string{"other", "group"}},
+		{ID: "4", Username: "dave", GroupIds: []string{"group"}},
+	}
+
+	t.Run("non-authoritative", func(t *testing.T) {
+		// when
+		add, remove, missing := planGroupMembership(users, "group",
+			map[string]bool{"alice": true, "carol": true}, map[string]bool{"alice": true, "bob": true}, false)
+
+		// then
+		assert.Equal(t, []api.User{users[1]}, add)
+		assert.Equal(t, []api.User{users[2]}, remove)
+		assert.Empty(t, missing)
+	})
+
+	t.Run("authoritative", func(t *testing.T) {
+		// when
+		add, remove, missing := planGroupMembership(users, "group",
+			map[string]bool{}, map[string]bool{"alice": true, "bob": true}, true)
+
+		// then
+		assert.Equal(t, []api.User{users[1]}, add)
+		assert.Equal(t, []api.User{users[2], users[3]}, remove)
+		assert.Empty(t, missing)
+	})
+
+	t.Run("missing users", func(t *testing.T) {
+		// when
+		_, _, missing := planGroupMembership(users, "group",
+			map[string]bool{}, map[string]bool{"zoe": true, "alice": true, "eve": true}, false)
+
+		// then
+		assert.Equal(t, []string{"eve", "zoe"}, missing)
+	})
+}
+
+func TestGroupMembers(t *testing.T) {
+	users := []api.User{
+		{Username: "carol", GroupIds: []string{"group"}},
+		{Username: "bob"},
+		{Username: "alice", GroupIds: []string{"group"}},
+	}
+	managed := map[string]bool{"alice": true, "bob": true}
+
+	assert.Equal(t, []string{"alice"}, groupMembers(users, "group", managed, false))
+	assert.Equal(t, []string{"alice", "carol"}, groupMembers(users, "group", managed, true))
+	assert.Equal(t, []string{}, groupMembers(users, "empty", managed, true))
+}
+
+func testAccCheckUserGroupMembershipDestroy(client *api.Client, username string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		users, err := client.ListUsers(context.Background())
+		if err != nil {
+			return err
+		}
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "openvpn_user_group_membership" {
+				continue
+			}
+
+			for _, user := range users {
+				if user.Username == username && isGroupMember(user, rs.Primary.ID) {
+					return fmt.Errorf("user %s is still a member of group %s", username, rs.Primary.ID)
+				}
+			}
+		}
+
+		return nil
+	}
+}
+
+func resourceUserGroupMembershipOutputConfig(name, groupID, username string) string {
+	return fmt.Sprintf(`
+provider "openvpn" {}
+
+resource "openvpn_user_group_membership" "%s" {
+	group_id = "%s"
+	usernames = ["%s"]
+}
+`, name, groupID, username)
+}
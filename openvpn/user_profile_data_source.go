@@ -0,0 +1,58 @@
+package openvpn
+
+import (
+	"context"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"strings"
+	"terraform-provider-openvpn/openvpn/api"
+	"time"
+)
+
+func dataSourceUserProfile() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceUserProfileRead,
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(2 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"user_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"device_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"region_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"profile": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"parsed_profile": parsedProfileSchema(),
+		},
+	}
+}
+
+func dataSourceUserProfileRead(ctx context.Context, data *schema.ResourceData, i interface{}) diag.Diagnostics {
+	client, ok := i.(*api.Client)
+	if !ok {
+		return diag.Errorf("invalid api client")
+	}
+
+	userId := data.Get("user_id").(string)
+	deviceId := data.Get("device_id").(string)
+	regionId := data.Get("region_id").(string)
+
+	userProfile, err := client.GetUserProfile(ctx, userId, deviceId, regionId)
+	if err != nil {
+		return diagFromAuthError(err)
+	}
+
+	data.SetId(strings.Join([]string{userId, deviceId, regionId}, "/"))
+	return setConnectorProfileData(data, userProfile)
+}
@@ -0,0 +1,44 @@
+package openvpn
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"testing"
+)
+
+func TestDataSourceUserProfile(t *testing.T) {
+	dataSourceName := "data.openvpn_user_profile.test"
+
+	client := getAuthenticatedClient(t)
+	userID := getTestUserID(t)
+	regionID := getDefaultRegionID(t, client)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			testAccPreCheck(t)
+		},
+		ProviderFactories: defaultProviderFactory,
+		Steps: []resource.TestStep{
+			{
+				Config: dataUserProfileOutputConfig(userID, regionID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "id", userID+"//"+regionID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "profile"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "parsed_profile.0.remote.0.host"),
+				),
+			},
+		},
+	})
+}
+
+func dataUserProfileOutputConfig(userID, regionID string) string {
+	return fmt.Sprintf(`
+provider "openvpn" {
+}
+
+data "openvpn_user_profile" "test" {
+  user_id   = "%s"
+  region_id = "%s"
+}
+`, userID, regionID)
+}
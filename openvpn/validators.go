@@ -0,0 +1,79 @@
+package openvpn
+
+import (
+	"fmt"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"net"
+	"regexp"
+	"strings"
+)
+
+var domainLabelRegexp = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// validateDomain checks that the value is a hostname as defined by RFC 1123.
+func validateDomain(i interface{}, path cty.Path) diag.Diagnostics {
+	domain, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected a string, got %T", i)
+	}
+	return diag.FromErr(validateDomainName(domain))
+}
+
+func validateDomainName(domain string) error {
+	if domain == "" || len(domain) > 253 {
+		return fmt.Errorf("invalid domain '%s': must be between 1 and 253 characters long", domain)
+	}
+	for _, label := range strings.Split(domain, ".") {
+		if !domainLabelRegexp.MatchString(label) {
+			return fmt.Errorf("invalid domain '%s': label '%s' must be 1 to 63 letters, digits or hyphens and must not start or end with a hyphen", domain, label)
+		}
+	}
+	return nil
+}
+
+// validateApplicationDomain accepts a hostname, optionally prefixed with a "*." wildcard label.
+func validateApplicationDomain(i interface{}, path cty.Path) diag.Diagnostics {
+	domain, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected a string, got %T", i)
+	}
+	return diag.FromErr(validateDomainName(strings.TrimPrefix(domain, "*.")))
+}
+
+// validateCountryISO checks that the value is an upper case ISO 3166-1 alpha-2 country code.
+func validateCountryISO(i interface{}, path cty.Path) diag.Diagnostics {
+	countryISO, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected a string, got %T", i)
+	}
+	if _, ok := countries[countryISO]; !ok {
+		if _, ok := countries[strings.ToUpper(countryISO)]; ok {
+			return diag.Errorf("invalid country '%s': use the upper case code '%s'", countryISO, strings.ToUpper(countryISO))
+		}
+		return diag.Errorf("invalid country '%s': expected an ISO 3166-1 alpha-2 code like 'US' or 'DE'", countryISO)
+	}
+	return nil
+}
+
+// validateIPv4CIDR checks that the value is an IPv4 network in CIDR notation.
+func validateIPv4CIDR(i interface{}, path cty.Path) diag.Diagnostics {
+	return validateCIDR(i, "IPv4", "10.0.0.0/16")
+}
+
+// validateIPv6CIDR checks that the value is an IPv6 network in CIDR notation.
+func validateIPv6CIDR(i interface{}, path cty.Path) diag.Diagnostics {
+	return validateCIDR(i, "IPv6", "fd00::/64")
+}
+
+func validateCIDR(i interface{}, family, example string) diag.Diagnostics {
+	cidr, ok := i.(string)
+	if !ok {
+		return diag.Errorf("expected a string, got %T", i)
+	}
+	ip, _, err := net.ParseCIDR(cidr)
+	if err != nil || (ip.To4() != nil) != (family == "IPv4") {
+		return diag.Errorf("invalid network '%s': expected an %s network in CIDR notation like '%s'", cidr, family, example)
+	}
+	return nil
+}
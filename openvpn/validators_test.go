@@ -0,0 +1,43 @@
+package openvpn
+
+import (
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestValidateDomainName(t *testing.T) {
+	validDomains := []string{"example.com", "host-1.internal.example.com", "localhost", "1.example"}
+	for _, domain := range validDomains {
+		assert.NoError(t, validateDomainName(domain), domain)
+	}
+
+	invalidDomains := []string{"", "example..com", "-example.com", "example-.com", "exa_mple.com", "example.com.", "host name.com"}
+	for _, domain := range invalidDomains {
+		assert.Error(t, validateDomainName(domain), domain)
+	}
+}
+
+func TestValidateApplicationDomain(t *testing.T) {
+	for _, domain := range []string{"wiki.example.com", "*.example.com"} {
+		assert.False(t, validateApplicationDomain(domain, nil).HasError(), domain)
+	}
+	for _, domain := range []string{"*", "*.*.example.com", "wiki.*.example.com", "*example.com"} {
+		assert.True(t, validateApplicationDomain(domain, nil).HasError(), domain)
+	}
+}
+
+func TestValidateCountryISO(t *testing.T) {
+	assert.False(t, validateCountryISO("DE", nil).HasError())
+	assert.Equal(t, "invalid country 'de': use the upper case code 'DE'", validateCountryISO("de", nil)[0].Summary)
+	assert.True(t, validateCountryISO("XX", nil).HasError())
+	assert.True(t, validateCountryISO("DEU", nil).HasError())
+}
+
+func TestValidateCIDR(t *testing.T) {
+	assert.False(t, validateIPv4CIDR("100.96.0.0/11", nil).HasError())
+	assert.True(t, validateIPv4CIDR("fd00::/64", nil).HasError())
+	assert.True(t, validateIPv4CIDR("100.96.0.1", nil).HasError())
+	assert.False(t, validateIPv6CIDR("fd00::/64", nil).HasError())
+	assert.Equal(t, "invalid network '100.96.0.0/11': expected an IPv6 network in CIDR notation like 'fd00::/64'",
+		validateIPv6CIDR("100.96.0.0/11", nil)[0].Summary)
+}